@@ -0,0 +1,127 @@
+package api
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+)
+
+const (
+	DefaultRateBurst = 10
+
+	// Header used by clients to identify themselves. Clients without a known API key are identified by their IP address.
+	apiKeyHeader = "X-API-Key"
+
+	// Maximum number of clients we keep rate limiting state for.
+	rateLimiterClientLimit = 10_000
+
+	// Suggested delay for retrying requests rejected because the head node has too many executions queued.
+	queueFullRetryAfter = 1 * time.Second
+)
+
+// rateLimiter keeps a token bucket for each client.
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	// Limiters for individual clients. Least recently seen clients are evicted once the cache is full.
+	sync.Mutex
+	clients *lru.Cache
+}
+
+func newRateLimiter(limit float64, burst uint) *rateLimiter {
+
+	// Only errors if the size is not positive.
+	clients, _ := lru.New(rateLimiterClientLimit)
+
+	r := rateLimiter{
+		limit:   rate.Limit(limit),
+		burst:   int(max(burst, 1)),
+		clients: clients,
+	}
+
+	return &r
+}
+
+// allow reports whether the client can make a request now. If not, it returns the time after which the request can be retried.
+func (r *rateLimiter) allow(client string) (bool, time.Duration) {
+
+	reservation := r.limiter(client).Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return true, 0
+	}
+
+	// Client is over the limit - don't take the token from the future.
+	reservation.Cancel()
+
+	return false, delay
+}
+
+func (r *rateLimiter) limiter(client string) *rate.Limiter {
+
+	r.Lock()
+	defer r.Unlock()
+
+	limiter, ok := r.clients.Get(client)
+	if ok {
+		return limiter.(*rate.Limiter)
+	}
+
+	l := rate.NewLimiter(r.limit, r.burst)
+	r.clients.Add(client, l)
+
+	return l
+}
+
+// admit checks whether the client is allowed to make the request. If not, the returned error should be returned by the handler.
+func (a *API) admit(ctx echo.Context) error {
+
+	if a.limiter == nil {
+		return nil
+	}
+
+	client := a.clientID(ctx)
+
+	ok, delay := a.limiter.allow(client)
+	if ok {
+		return nil
+	}
+
+	a.Log.Debug().Str("client", client).Dur("retry_after", delay).Msg("client over the rate limit")
+
+	return a.tooManyRequests(ctx, "rate_limit", delay, errors.New("rate limit exceeded"))
+}
+
+// tooManyRequests responds with HTTP 429, letting the client know when it can retry the request.
+func (a *API) tooManyRequests(ctx echo.Context, reason string, retryAfter time.Duration, err error) error {
+
+	a.metrics.IncrCounterWithLabels(requestsRejectedMetric, 1, []metrics.Label{{Name: "reason", Value: reason}})
+
+	seconds := max(int(math.Ceil(retryAfter.Seconds())), 1)
+	ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(seconds))
+
+	return echo.NewHTTPError(http.StatusTooManyRequests, err)
+}
+
+// clientID returns the identifier of the client making the request - its API key, if it is one of the known keys, or its IP address.
+// Clients cannot get a fresh rate limit by making up keys. IP address is determined by the IP extractor of the server,
+// so it cannot be spoofed using headers unless the request came through a trusted proxy.
+func (a *API) clientID(ctx echo.Context) string {
+
+	key := ctx.Request().Header.Get(apiKeyHeader)
+	_, known := a.apiKeys[key]
+	if key != "" && known {
+		return "key:" + key
+	}
+
+	return "ip:" + ctx.RealIP()
+}
@@ -0,0 +1,142 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_Admission(t *testing.T) {
+	t.Run("client over the rate limit", func(t *testing.T) {
+		t.Parallel()
+
+		const burst = 2
+
+		// Refill rate is low enough that no tokens are added during the test.
+		srv := api.New(mocks.NoopLogger, mocks.BaselineNode(t),
+			api.RateLimit(0.001, burst),
+			api.APIKeys("client-a", "client-b"),
+		)
+
+		for range burst {
+			_, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest, withKey("client-a"))
+			require.NoError(t, err)
+
+			err = srv.ExecuteFunction(ctx)
+			require.NoError(t, err)
+		}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest, withKey("client-a"))
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+		require.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+
+		// Other clients are not affected.
+		_, ctx, err = setupRecorder(executeEndpoint, mocks.GenericExecutionRequest, withKey("client-b"))
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("unknown API keys are rate limited by IP address", func(t *testing.T) {
+		t.Parallel()
+
+		const burst = 2
+
+		srv := api.New(mocks.NoopLogger, mocks.BaselineNode(t),
+			api.RateLimit(0.001, burst),
+			api.APIKeys("client-a"),
+		)
+
+		for i := range burst {
+			_, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest, withKey(fmt.Sprintf("unknown-%v", i)))
+			require.NoError(t, err)
+
+			err = srv.ExecuteFunction(ctx)
+			require.NoError(t, err)
+		}
+
+		// Made up key does not give the client a fresh rate limit.
+		_, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest, withKey("unknown-other"))
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+
+		// Known clients are not affected.
+		_, ctx, err = setupRecorder(executeEndpoint, mocks.GenericExecutionRequest, withKey("client-a"))
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("node execution queue full", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			return codes.TooManyRequests, "", nil, execute.Cluster{}, bls.ErrTooManyRequests
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+		require.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+	})
+	t.Run("stream rejected before it starts", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionWithProgressFunc = func(context.Context, execute.Request, string, func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			return codes.TooManyRequests, "", nil, execute.Cluster{}, bls.ErrTooManyRequests
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(streamEndpoint, mocks.GenericExecutionRequest)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionStream(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+		require.False(t, ctx.Response().Committed)
+		require.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+	})
+}
+
+func withKey(key string) func(*http.Request) {
+	return func(req *http.Request) {
+		req.Header.Set("X-API-Key", key)
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"github.com/armon/go-metrics"
 	"github.com/rs/zerolog"
 )
 
@@ -8,14 +9,36 @@ import (
 type API struct {
 	Log  zerolog.Logger
 	Node Node
+
+	cfg     Config
+	metrics *metrics.Metrics
+	limiter *rateLimiter
+	apiKeys map[string]struct{}
 }
 
 // New creates a new instance of a Bless head node REST API. Access to node data is provided by the provided `node`.
-func New(log zerolog.Logger, node Node) *API {
+func New(log zerolog.Logger, node Node, options ...Option) *API {
+
+	cfg := DefaultConfig
+	for _, option := range options {
+		option(&cfg)
+	}
 
 	api := API{
 		Log:  log,
 		Node: node,
+
+		cfg:     cfg,
+		metrics: metrics.Default(),
+		apiKeys: make(map[string]struct{}, len(cfg.APIKeys)),
+	}
+
+	for _, key := range cfg.APIKeys {
+		api.apiKeys[key] = struct{}{}
+	}
+
+	if cfg.RateLimit > 0 {
+		api.limiter = newRateLimiter(cfg.RateLimit, cfg.RateBurst)
 	}
 
 	return &api
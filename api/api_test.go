@@ -15,10 +15,19 @@ import (
 )
 
 const (
-	executeEndpoint = "/api/v1/functions/execute"
-	installEndpoint = "/api/v1/functions/install"
-	resultEndpoint  = "/api/v1/functions/requests/result"
-	healthEndpoint  = "/api/v1/health"
+	executeEndpoint    = "/api/v1/functions/execute"
+	streamEndpoint     = "/api/v1/functions/execute/stream"
+	pipelineEndpoint   = "/api/v1/functions/pipeline"
+	batchEndpoint      = "/api/v1/functions/batch"
+	installEndpoint    = "/api/v1/functions/install"
+	uninstallEndpoint  = "/api/v1/functions/uninstall"
+	resultEndpoint     = "/api/v1/functions/requests/result"
+	statusEndpoint     = "/api/v1/functions/requests/status"
+	cancelEndpoint     = "/api/v1/functions/requests/"
+	scheduleEndpoint   = "/api/v1/schedules"
+	reputationEndpoint = "/api/v1/peers/reputation"
+	functionsEndpoint  = "/api/v1/functions"
+	healthEndpoint     = "/api/v1/health"
 )
 
 func setupAPI(t *testing.T) *api.API {
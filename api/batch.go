@@ -0,0 +1,77 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// ExecuteBatch implements the REST API endpoint for executing a function for a batch of inputs.
+func (a *API) ExecuteBatch(ctx echo.Context) error {
+
+	err := a.admit(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Unpack the API request.
+	var req BatchRequest
+	err = ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	batch := execute.Batch{
+		Request: execute.Request{
+			Config:     req.Config,
+			FunctionID: req.FunctionId,
+			Method:     req.Method,
+			Parameters: req.Parameters,
+		},
+		Items: req.Items,
+	}
+
+	err = batch.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	// Start the batch in the background and return the request ID right away.
+	if req.Async {
+
+		id, err := a.Node.ExecuteBatchAsync(ctx.Request().Context(), batch, req.Topic)
+		if errors.Is(err, bls.ErrTooManyRequests) {
+			return a.tooManyRequests(ctx, "queue_full", queueFullRetryAfter, err)
+		}
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not start batch execution: %w", err))
+		}
+
+		res := ExecutionResponse{
+			Code:      codes.Accepted.String(),
+			RequestId: id,
+		}
+
+		return ctx.JSON(http.StatusAccepted, res)
+	}
+
+	code, id, items, cluster, err := a.Node.ExecuteBatch(ctx.Request().Context(), batch, req.Topic)
+	if errors.Is(err, bls.ErrTooManyRequests) {
+		return a.tooManyRequests(ctx, "queue_full", queueFullRetryAfter, err)
+	}
+	if err != nil {
+		a.Log.Warn().Str("function", req.FunctionId).Err(err).Msg("node failed to execute batch")
+	}
+
+	res := executionResponse(code, id, nil, batch.Config.ResultAggregation, cluster, err)
+	res.Batch = items
+	a.signResponse(&res)
+
+	return ctx.JSON(http.StatusOK, res)
+}
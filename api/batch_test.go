@@ -0,0 +1,114 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_ExecuteBatch(t *testing.T) {
+
+	req := api.BatchRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Items: []api.BatchItem{
+			{Parameters: []execute.Parameter{{Value: "first"}}},
+			{Parameters: []execute.Parameter{{Value: "second"}}},
+		},
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteBatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, codes.OK.String(), res.Code)
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+		require.Len(t, res.Batch, len(req.Items))
+		for _, item := range res.Batch {
+			require.Equal(t, codes.OK, item.Code)
+			require.Equal(t, mocks.GenericPeerID, item.Peer)
+			require.Equal(t, mocks.GenericExecutionResult.Result, item.Result)
+		}
+	})
+	t.Run("async batch", func(t *testing.T) {
+		t.Parallel()
+
+		req := req
+		req.Async = true
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteBatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, codes.Accepted.String(), res.Code)
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+		require.Empty(t, res.Batch)
+	})
+	t.Run("batch without items is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		req := req
+		req.Items = nil
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteBatch(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node rejects batch", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteBatchFunc = func(context.Context, execute.Batch, string) (codes.Code, string, []execute.BatchItemResult, execute.Cluster, error) {
+			return codes.TooManyRequests, "", nil, execute.Cluster{}, bls.ErrTooManyRequests
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteBatch(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+	})
+}
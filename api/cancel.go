@@ -0,0 +1,41 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+// CancelExecution implements the REST API endpoint for cancelling an in-progress execution request.
+func (a *API) CancelExecution(ctx echo.Context, id string) error {
+
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing request ID"))
+	}
+
+	peers, confirmed, err := a.Node.CancelExecution(ctx.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		if errors.Is(err, bls.ErrExecutionNotOwned) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+
+		a.Log.Warn().Str("request", id).Err(err).Msg("could not cancel execution")
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not cancel execution: %w", err))
+	}
+
+	res := FunctionCancelResponse{
+		RequestId: id,
+		Peers:     bls.PeerIDsToStr(peers),
+		Confirmed: bls.PeerIDsToStr(confirmed),
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
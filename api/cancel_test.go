@@ -0,0 +1,116 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_CancelExecution(t *testing.T) {
+	t.Run("execution cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(cancelEndpoint, nil)
+		require.NoError(t, err)
+
+		requestID := mocks.GenericUUID.String()
+
+		err = srv.CancelExecution(ctx, requestID)
+		require.NoError(t, err)
+
+		var res api.FunctionCancelResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, requestID, res.RequestId)
+		require.Equal(t, []string{mocks.GenericPeerID.String()}, res.Peers)
+		require.Equal(t, []string{mocks.GenericPeerID.String()}, res.Confirmed)
+	})
+	t.Run("request not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(context.Context, string) ([]peer.ID, []peer.ID, error) {
+			return nil, nil, bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(cancelEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx, "dummy-request-id")
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("request handled by a different head", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(context.Context, string) ([]peer.ID, []peer.ID, error) {
+			return nil, nil, bls.ErrExecutionNotOwned
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(cancelEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx, mocks.GenericUUID.String())
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusConflict, echoErr.Code)
+	})
+	t.Run("node fails to cancel", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(context.Context, string) ([]peer.ID, []peer.ID, error) {
+			return nil, nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(cancelEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx, mocks.GenericUUID.String())
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+	t.Run("missing request ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(cancelEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx, "")
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
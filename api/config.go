@@ -0,0 +1,32 @@
+package api
+
+// Option can be used to set API configuration options.
+type Option func(*Config)
+
+// DefaultConfig represents the default settings for the API.
+var DefaultConfig = Config{
+	RateLimit: 0,
+	RateBurst: DefaultRateBurst,
+}
+
+// Config represents the API configuration.
+type Config struct {
+	RateLimit float64  // Number of execution requests per second a single client can make. Zero means no limit.
+	RateBurst uint     // Maximum number of execution requests a single client can make at once.
+	APIKeys   []string // API keys of known clients. Clients using other keys are rate limited by their IP address.
+}
+
+// RateLimit sets the number of execution requests per second a single client can make, and how many requests it can make at once.
+func RateLimit(limit float64, burst uint) Option {
+	return func(cfg *Config) {
+		cfg.RateLimit = limit
+		cfg.RateBurst = burst
+	}
+}
+
+// APIKeys sets the API keys of known clients, which are rate limited by their key instead of their IP address.
+func APIKeys(keys ...string) Option {
+	return func(cfg *Config) {
+		cfg.APIKeys = keys
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/node/aggregate"
 )
@@ -15,9 +16,14 @@ import (
 // ExecuteFunction implements the REST API endpoint for function execution.
 func (a *API) ExecuteFunction(ctx echo.Context) error {
 
+	err := a.admit(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Unpack the API request.
 	var req ExecutionRequest
-	err := ctx.Bind(&req)
+	err = ctx.Bind(&req)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
 	}
@@ -34,25 +40,75 @@ func (a *API) ExecuteFunction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
 	}
 
+	// Start the execution in the background and return the request ID right away.
+	if req.Async {
+
+		var id string
+		if req.IdempotencyKey != "" {
+			id, err = a.Node.ExecuteFunctionAsyncIdempotent(ctx.Request().Context(), req.IdempotencyKey, exr, req.Topic)
+		} else {
+			id, err = a.Node.ExecuteFunctionAsync(ctx.Request().Context(), exr, req.Topic)
+		}
+		if errors.Is(err, bls.ErrTooManyRequests) {
+			return a.tooManyRequests(ctx, "queue_full", queueFullRetryAfter, err)
+		}
+		if errors.Is(err, bls.ErrIdempotencyKeyMismatch) {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
+		}
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not start execution: %w", err))
+		}
+
+		res := ExecutionResponse{
+			Code:      codes.Accepted.String(),
+			RequestId: id,
+		}
+
+		return ctx.JSON(http.StatusAccepted, res)
+	}
+
 	// Get the execution result.
-	code, id, results, cluster, err := a.Node.ExecuteFunction(ctx.Request().Context(), exr, req.Topic)
+	var (
+		code    codes.Code
+		id      string
+		results execute.ResultMap
+		cluster execute.Cluster
+	)
+	if req.IdempotencyKey != "" {
+		code, id, results, cluster, err = a.Node.ExecuteFunctionIdempotent(ctx.Request().Context(), req.IdempotencyKey, exr, req.Topic, nil)
+	} else {
+		code, id, results, cluster, err = a.Node.ExecuteFunction(ctx.Request().Context(), exr, req.Topic)
+	}
+	if errors.Is(err, bls.ErrTooManyRequests) {
+		return a.tooManyRequests(ctx, "queue_full", queueFullRetryAfter, err)
+	}
+	if errors.Is(err, bls.ErrIdempotencyKeyMismatch) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
+	}
 	if err != nil {
 		a.Log.Warn().Str("function", req.FunctionId).Err(err).Msg("node failed to execute function")
 	}
 
-	// Transform the node response format to the one returned by the API.
+	res := executionResponse(code, id, results, exr.Config.ResultAggregation, cluster, err)
+	a.signResponse(&res)
+
+	// Send the response.
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// executionResponse transforms the node response format to the one returned by the API.
+func executionResponse(code codes.Code, id string, results execute.ResultMap, aggregation execute.ResultAggregation, cluster execute.Cluster, err error) ExecutionResponse {
+
 	res := ExecutionResponse{
-		Code:      string(code),
-		RequestId: id,
-		Results:   aggregate.Aggregate(results),
-		Cluster:   cluster,
+		Code:        string(code),
+		RequestId:   id,
+		Results:     aggregate.Aggregate(results, aggregation),
+		Aggregation: aggregation.Strategy(),
+		Cluster:     cluster,
 	}
 
-	// Communicate the reason for failure in these cases.
-	if errors.Is(err, bls.ErrRollCallTimeout) || errors.Is(err, bls.ErrExecutionNotEnoughNodes) {
-		res.Message = err.Error()
-	}
+	// Communicate the reason for failure.
+	res.Message = bls.FailureMessage(err)
 
-	// Send the response.
-	return ctx.JSON(http.StatusOK, res)
+	return res
 }
@@ -7,10 +7,12 @@ import (
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/testing/mocks"
@@ -68,10 +70,297 @@ func TestAPI_Execute(t *testing.T) {
 	require.Equal(t, executionResult.Result, res.Results[0].Result)
 	require.Equal(t, float64(100), res.Results[0].Frequency)
 	require.Equal(t, peerIDs, res.Results[0].Peers)
+	require.Equal(t, execute.AggregationExact, res.Aggregation)
 
 	require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
 }
 
+func TestAPI_Execute_FailureMessage(t *testing.T) {
+
+	tests := []struct {
+		err     error
+		message string
+	}{
+		{err: bls.ErrRollCallTimeout, message: bls.ErrRollCallTimeout.Error()},
+		{err: bls.ErrExecutionCancelled, message: bls.ErrExecutionCancelled.Error()},
+		{err: bls.ErrRetriesExhausted, message: bls.ErrRetriesExhausted.Error()},
+		{err: mocks.GenericError, message: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			t.Parallel()
+
+			node := mocks.BaselineNode(t)
+			node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+				return codes.Error, mocks.GenericUUID.String(), nil, execute.Cluster{}, test.err
+			}
+
+			srv := api.New(mocks.NoopLogger, node)
+
+			rec, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest)
+			require.NoError(t, err)
+
+			err = srv.ExecuteFunction(ctx)
+			require.NoError(t, err)
+
+			var res api.ExecutionResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+			require.Equal(t, test.message, res.Message)
+		})
+	}
+}
+
+func TestAPI_Execute_ResultAggregation(t *testing.T) {
+	t.Run("results aggregated using requested strategy", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+
+			res := execute.ResultMap{
+				mocks.GenericPeerIDs[0]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "1"}}},
+				mocks.GenericPeerIDs[1]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "2"}}},
+				mocks.GenericPeerIDs[2]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "9"}}},
+			}
+
+			return codes.OK, mocks.GenericUUID.String(), res, execute.Cluster{}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.ResultAggregation = execute.ResultAggregation{Enable: true, Type: execute.AggregationMedian}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, execute.AggregationMedian, res.Aggregation)
+		require.Len(t, res.Results, 1)
+		require.Equal(t, "2", res.Results[0].Result.Stdout)
+	})
+	t.Run("unknown strategy is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.ResultAggregation = execute.ResultAggregation{Enable: true, Type: "unknown"}
+
+		_, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_Execute_SignedResponse(t *testing.T) {
+
+	priv, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+
+	head, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+
+	node := mocks.BaselineNode(t)
+	node.SignResponseFunc = func(res *execute.SignedResponse) error {
+		return res.Sign(priv)
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	rec, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var res api.ExecutionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	require.Equal(t, head.String(), res.Head)
+	require.NotEmpty(t, res.Signature)
+
+	err = execute.VerifyResponse(rec.Body.Bytes(), head)
+	require.NoError(t, err)
+}
+
+func TestAPI_Execute_Async(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			require.FailNow(t, "unexpected synchronous execution")
+			return codes.Error, "", nil, execute.Cluster{}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Async:      true,
+		}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+		require.Equal(t, codes.Accepted.String(), res.Code)
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+		require.Empty(t, res.Results)
+	})
+	t.Run("node fails to start execution", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionAsyncFunc = func(context.Context, execute.Request, string) (string, error) {
+			return "", mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Async:      true,
+		}
+
+		_, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_Execute_IdempotencyKey(t *testing.T) {
+
+	const key = "idempotency-key"
+
+	t.Run("keyed request uses idempotent execution", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			require.FailNow(t, "unexpected execution without idempotency key")
+			return codes.Error, "", nil, execute.Cluster{}, nil
+		}
+		node.ExecuteFunctionIdempotentFunc = func(_ context.Context, k string, _ execute.Request, _ string, _ func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			require.Equal(t, key, k)
+			return mocks.GenericExecutionResult.Code, mocks.GenericUUID.String(), mocks.GenericExecutionResultMap, execute.Cluster{}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionRequest{
+			FunctionId:     mocks.GenericExecutionRequest.FunctionID,
+			Method:         mocks.GenericExecutionRequest.Method,
+			IdempotencyKey: key,
+		}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+		require.Equal(t, mocks.GenericExecutionResult.Code.String(), res.Code)
+	})
+	t.Run("keyed async request uses idempotent execution", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionAsyncFunc = func(context.Context, execute.Request, string) (string, error) {
+			require.FailNow(t, "unexpected execution without idempotency key")
+			return "", nil
+		}
+		node.ExecuteFunctionAsyncIdempotentFunc = func(_ context.Context, k string, _ execute.Request, _ string) (string, error) {
+			require.Equal(t, key, k)
+			return mocks.GenericUUID.String(), nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionRequest{
+			FunctionId:     mocks.GenericExecutionRequest.FunctionID,
+			Method:         mocks.GenericExecutionRequest.Method,
+			Async:          true,
+			IdempotencyKey: key,
+		}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+	})
+	t.Run("key reused for a different request", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionIdempotentFunc = func(context.Context, string, execute.Request, string, func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			return codes.Invalid, "", nil, execute.Cluster{}, bls.ErrIdempotencyKeyMismatch
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionRequest{
+			FunctionId:     mocks.GenericExecutionRequest.FunctionID,
+			Method:         mocks.GenericExecutionRequest.Method,
+			IdempotencyKey: key,
+		}
+
+		_, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusUnprocessableEntity, echoErr.Code)
+	})
+}
+
 func TestAPI_Execute_HandlesErrors(t *testing.T) {
 
 	executionResult := execute.Result{
@@ -118,6 +407,33 @@ func TestAPI_Execute_HandlesErrors(t *testing.T) {
 	require.Equal(t, mocks.GenericPeerID, res.Results[0].Peers[0])
 }
 
+func TestAPI_Execute_InvalidRetryPolicy(t *testing.T) {
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		require.FailNow(t, "request with invalid retry policy should not be executed")
+		return codes.Error, "", nil, execute.Cluster{}, nil
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	req := mocks.GenericExecutionRequest
+	req.Config.Retry = &execute.RetryPolicy{
+		MaxAttempts: execute.MaxRetryAttempts + 1,
+	}
+
+	_, ctx, err := setupRecorder(executeEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.Error(t, err)
+
+	echoErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+
+	require.Equal(t, http.StatusBadRequest, echoErr.Code)
+}
+
 func TestAPI_Execute_HandlesMalformedRequests(t *testing.T) {
 
 	api := setupAPI(t)
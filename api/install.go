@@ -7,6 +7,8 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
 )
 
 func (r FunctionInstallRequest) Valid() error {
@@ -32,13 +34,74 @@ func (a *API) InstallFunction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
 	}
 
-	err = a.Node.PublishFunctionInstall(ctx.Request().Context(), req.Uri, req.Cid, req.Topic)
+	install, err := a.Node.InstallFunction(ctx.Request().Context(), req.Uri, req.Cid, req.Topic)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("function installation failed: %w", err))
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"code": strconv.Itoa(http.StatusOK),
-	})
+	return ctx.JSON(http.StatusOK, functionInstallResponse(install))
+}
+
+// InstallFunctionAsync implements the REST API endpoint for installing a function without waiting for the install responses.
+func (a *API) InstallFunctionAsync(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req FunctionInstallRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	id, err := a.Node.InstallFunctionAsync(ctx.Request().Context(), req.Uri, req.Cid, req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("function installation failed: %w", err))
+	}
+
+	return ctx.JSON(http.StatusAccepted, FunctionInstallAsyncResponse{RequestId: id})
+}
+
+// GetFunctionInstall implements the REST API endpoint for retrieving the outcome of an asynchronous function install.
+func (a *API) GetFunctionInstall(ctx echo.Context, id string) error {
+
+	install, ok := a.Node.FunctionInstallResult(ctx.Request().Context(), id)
+	if !ok {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	return ctx.JSON(http.StatusOK, functionInstallResponse(install))
+}
+
+func functionInstallResponse(install bls.FunctionInstall) FunctionInstallResponse {
+
+	res := FunctionInstallResponse{
+		Code:      strconv.Itoa(http.StatusOK),
+		RequestId: install.RequestID,
+		Cid:       install.CID,
+		Done:      install.Done,
+		Succeeded: make([]string, 0, len(install.Succeeded)),
+		Failed:    make([]FunctionInstallFailure, 0, len(install.Failed)),
+		NoAnswer:  make([]string, 0, len(install.NoAnswer)),
+	}
+
+	for _, peer := range install.Succeeded {
+		res.Succeeded = append(res.Succeeded, peer.String())
+	}
+
+	for _, failure := range install.Failed {
+		res.Failed = append(res.Failed, FunctionInstallFailure{
+			Peer:  failure.Peer.String(),
+			Error: failure.Error,
+		})
+	}
+
+	for _, peer := range install.NoAnswer {
+		res.NoAnswer = append(res.NoAnswer, peer.String())
+	}
 
+	return res
 }
@@ -2,6 +2,7 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/testing/mocks"
 )
 
@@ -30,7 +32,141 @@ func TestAPI_FunctionInstall(t *testing.T) {
 		require.NoError(t, err)
 
 		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionInstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		requireInstallResponse(t, mocks.GenericFunctionInstall, res)
+	})
+}
+
+func TestAPI_FunctionInstallAsync(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		req := api.FunctionInstallRequest{
+			Cid:   "dummy-cid",
+			Topic: "dummy-topic",
+		}
+
+		node := mocks.BaselineNode(t)
+		node.InstallFunctionAsyncFunc = func(_ context.Context, _ string, cid string, subgroup string) (string, error) {
+			require.Equal(t, req.Cid, cid)
+			require.Equal(t, req.Topic, subgroup)
+			return mocks.GenericUUID.String(), nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(installEndpoint+"/async", req)
+		require.NoError(t, err)
+
+		err = srv.InstallFunctionAsync(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+
+		var res api.FunctionInstallAsyncResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+	})
+	t.Run("missing CID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(installEndpoint+"/async", api.FunctionInstallRequest{})
+		require.NoError(t, err)
+
+		err = srv.InstallFunctionAsync(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node fails to install function", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.InstallFunctionAsyncFunc = func(context.Context, string, string, string) (string, error) {
+			return "", mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(installEndpoint+"/async", api.FunctionInstallRequest{Cid: "dummy-cid"})
+		require.NoError(t, err)
+
+		err = srv.InstallFunctionAsync(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_GetFunctionInstall(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(installEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.GetFunctionInstall(ctx, mocks.GenericUUID.String())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionInstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		requireInstallResponse(t, mocks.GenericFunctionInstall, res)
 	})
+	t.Run("unknown request", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.FunctionInstallResultFunc = func(context.Context, string) (bls.FunctionInstall, bool) {
+			return bls.FunctionInstall{}, false
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(installEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.GetFunctionInstall(ctx, "unknown-id")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+}
+
+func requireInstallResponse(t *testing.T, install bls.FunctionInstall, res api.FunctionInstallResponse) {
+	t.Helper()
+
+	require.Equal(t, install.RequestID, res.RequestId)
+	require.Equal(t, install.CID, res.Cid)
+	require.Equal(t, install.Done, res.Done)
+
+	require.Len(t, res.Succeeded, len(install.Succeeded))
+	for i, peer := range install.Succeeded {
+		require.Equal(t, peer.String(), res.Succeeded[i])
+	}
+
+	require.Len(t, res.Failed, len(install.Failed))
+	for i, failure := range install.Failed {
+		require.Equal(t, failure.Peer.String(), res.Failed[i].Peer)
+		require.Equal(t, failure.Error, res.Failed[i].Error)
+	}
+
+	require.Len(t, res.NoAnswer, len(install.NoAnswer))
+	for i, peer := range install.NoAnswer {
+		require.Equal(t, peer.String(), res.NoAnswer[i])
+	}
 }
 
 func TestAPI_FunctionInstall_HandlesErrors(t *testing.T) {
@@ -59,8 +195,8 @@ func TestAPI_FunctionInstall_HandlesErrors(t *testing.T) {
 		t.Parallel()
 
 		node := mocks.BaselineNode(t)
-		node.PublishFunctionInstallFunc = func(context.Context, string, string, string) error {
-			return mocks.GenericError
+		node.InstallFunctionFunc = func(context.Context, string, string, string) (bls.FunctionInstall, error) {
+			return bls.FunctionInstall{}, mocks.GenericError
 		}
 
 		srv := api.New(mocks.NoopLogger, node)
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+// ListFunctions implements the REST API endpoint for listing functions installed on worker nodes.
+func (a *API) ListFunctions(ctx echo.Context, params ListFunctionsParams) error {
+
+	inventory, err := a.Node.FunctionInventory(ctx.Request().Context(), topicParam(params.Topic))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not get function inventory: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, functionInventory(inventory))
+}
+
+// GetFunction implements the REST API endpoint for listing worker nodes that have a function installed.
+func (a *API) GetFunction(ctx echo.Context, cid string, params GetFunctionParams) error {
+
+	inventory, err := a.Node.FunctionInventory(ctx.Request().Context(), topicParam(params.Topic))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not get function inventory: %w", err))
+	}
+
+	for _, fn := range functionInventory(inventory) {
+		if fn.Cid == cid {
+			return ctx.JSON(http.StatusOK, fn)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNotFound)
+}
+
+// functionInventory groups the functions reported by peers by their CID.
+func functionInventory(inventory map[peer.ID][]bls.InstalledFunction) []FunctionInventory {
+
+	functions := make(map[string]*FunctionInventory)
+	for peer, installed := range inventory {
+		for _, fn := range installed {
+
+			entry, ok := functions[fn.CID]
+			if !ok {
+				entry = &FunctionInventory{
+					Cid:           fn.CID,
+					Installations: []FunctionInstallation{},
+				}
+				functions[fn.CID] = entry
+			}
+
+			entry.Name = cmp.Or(entry.Name, fn.Name)
+			entry.Installations = append(entry.Installations, FunctionInstallation{
+				Peer:          peer.String(),
+				UpdatedAt:     fn.UpdatedAt,
+				LastRetrieved: fn.LastRetrieved,
+			})
+		}
+	}
+
+	res := make([]FunctionInventory, 0, len(functions))
+	for _, fn := range functions {
+		slices.SortFunc(fn.Installations, func(a, b FunctionInstallation) int {
+			return cmp.Compare(a.Peer, b.Peer)
+		})
+		res = append(res, *fn)
+	}
+
+	slices.SortFunc(res, func(a, b FunctionInventory) int {
+		return cmp.Compare(a.Cid, b.Cid)
+	})
+
+	return res
+}
+
+func topicParam(topic *string) string {
+	if topic == nil {
+		return ""
+	}
+
+	return *topic
+}
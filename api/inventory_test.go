@@ -0,0 +1,116 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_ListFunctions(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			first  = mocks.GenericFunctionRecord.Installed()
+			second = bls.InstalledFunction{CID: "second-cid", Name: "second"}
+			topic  = "topic"
+		)
+
+		node := mocks.BaselineNode(t)
+		node.FunctionInventoryFunc = func(_ context.Context, subgroup string) (map[peer.ID][]bls.InstalledFunction, error) {
+			require.Equal(t, topic, subgroup)
+
+			inventory := map[peer.ID][]bls.InstalledFunction{
+				mocks.GenericPeerIDs[0]: {first, second},
+				mocks.GenericPeerIDs[1]: {first},
+			}
+			return inventory, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(functionsEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListFunctions(ctx, api.ListFunctionsParams{Topic: &topic})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res []api.FunctionInventory
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res, 2)
+
+		require.Equal(t, first.CID, res[0].Cid)
+		require.Equal(t, first.Name, res[0].Name)
+		require.Len(t, res[0].Installations, 2)
+
+		require.Equal(t, second.CID, res[1].Cid)
+		require.Len(t, res[1].Installations, 1)
+		require.Equal(t, mocks.GenericPeerIDs[0].String(), res[1].Installations[0].Peer)
+	})
+	t.Run("node fails to collect inventory", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.FunctionInventoryFunc = func(context.Context, string) (map[peer.ID][]bls.InstalledFunction, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(functionsEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListFunctions(ctx, api.ListFunctionsParams{})
+		require.Error(t, err)
+
+		var echoErr *echo.HTTPError
+		require.True(t, errors.As(err, &echoErr))
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_GetFunction(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+		installed := mocks.GenericFunctionRecord.Installed()
+
+		rec, ctx, err := setupRecorder(functionsEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.GetFunction(ctx, installed.CID, api.GetFunctionParams{})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionInventory
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, installed.CID, res.Cid)
+		require.Len(t, res.Installations, 1)
+		require.Equal(t, mocks.GenericPeerID.String(), res.Installations[0].Peer)
+	})
+	t.Run("function not installed", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(functionsEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.GetFunction(ctx, "unknown-cid", api.GetFunctionParams{})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+}
@@ -4,6 +4,9 @@
 package api
 
 import (
+	"time"
+
+	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/node/aggregate"
 )
@@ -17,6 +20,39 @@ type AggregatedResults = aggregate.Results
 // AttributeAttestors Require specific attestors as vouchers
 type AttributeAttestors = execute.AttributeAttestors
 
+// AttributeRequirement Requirement for a single Node attribute
+type AttributeRequirement = execute.AttributeRequirement
+
+// BatchItem Inputs for a single execution in a batch
+type BatchItem = execute.BatchItem
+
+// BatchItemResult Result of a single execution in a batch
+type BatchItemResult = execute.BatchItemResult
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	// Async Return the request ID immediately and run the batch in the background. Batch progress and results can be retrieved using the request ID
+	Async bool `json:"async,omitempty"`
+
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// FunctionId CID of the function
+	FunctionId string `json:"function_id"`
+
+	// Items Inputs for the individual executions, at most 1000
+	Items []BatchItem `json:"items"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Parameters CLI arguments for the Bless Function, shared by all batch items
+	Parameters []ExecutionParameter `json:"parameters,omitempty"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
 // ExecutionConfig Configuration options for the Execution Request
 type ExecutionConfig = execute.Config
 
@@ -25,12 +61,19 @@ type ExecutionParameter = execute.Parameter
 
 // ExecutionRequest defines model for ExecutionRequest.
 type ExecutionRequest struct {
+	// Async Return the request ID immediately and run the execution in the background. Execution status and result can be retrieved using the request ID
+	Async bool `json:"async,omitempty"`
+
 	// Config Configuration options for the Execution Request
 	Config ExecutionConfig `json:"config,omitempty"`
 
 	// FunctionId CID of the function
 	FunctionId string `json:"function_id"`
 
+	// IdempotencyKey Client-chosen key identifying the request. Retried requests with the same key and body do not start a new execution -
+	// they join the execution in progress or return its result. Reusing the key with a different request body is rejected
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+
 	// Method Name of the WASM file to execute
 	Method string `json:"method"`
 
@@ -43,12 +86,21 @@ type ExecutionRequest struct {
 
 // ExecutionResponse defines model for ExecutionResponse.
 type ExecutionResponse struct {
+	// Aggregation Result aggregation strategy applied to the results
+	Aggregation string `json:"aggregation,omitempty"`
+
+	// Batch Results of the batch items, for batch executions. Ordered as the batch items
+	Batch []BatchItemResult `json:"batch,omitempty"`
+
 	// Cluster Information about the cluster of nodes that executed this request
 	Cluster NodeCluster `json:"cluster,omitempty"`
 
 	// Code Status of the execution
 	Code string `json:"code,omitempty"`
 
+	// Head LibP2P ID of the head node that signed the response
+	Head string `json:"head,omitempty"`
+
 	// Message If the Execution Request failed, this message might have more info about the error
 	Message string `json:"message,omitempty"`
 
@@ -57,11 +109,42 @@ type ExecutionResponse struct {
 
 	// Results List of unique results of the Execution Request
 	Results AggregatedResults `json:"results,omitempty"`
+
+	// Signature Signature of the response, made using the head node key. Covers the request ID, code, results, cluster and batch fields.
+	// Metadata of the results is covered as it was serialized. Response can be verified using the `keyforge` utility
+	Signature string `json:"signature,omitempty"`
 }
 
 // ExecutionResult Actual outputs of the execution, like Standard Output, Standard Error, Exit Code etc..
 type ExecutionResult = execute.RuntimeOutput
 
+// FunctionCancelResponse Outcome of an Execution Request cancellation
+type FunctionCancelResponse struct {
+	// Confirmed LibP2P IDs of the Nodes that confirmed cancellation
+	Confirmed []string `json:"confirmed,omitempty"`
+
+	// Peers LibP2P IDs of the Nodes that were working on the Execution Request
+	Peers []string `json:"peers,omitempty"`
+
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+}
+
+// FunctionInstallAsyncResponse defines model for FunctionInstallAsyncResponse.
+type FunctionInstallAsyncResponse struct {
+	// RequestId ID of the installation request
+	RequestId string `json:"request_id,omitempty"`
+}
+
+// FunctionInstallFailure Failed installation or uninstallation of a Bless Function on a Node
+type FunctionInstallFailure struct {
+	// Error Error reported by the Node
+	Error string `json:"error,omitempty"`
+
+	// Peer LibP2P ID of the Node
+	Peer string `json:"peer,omitempty"`
+}
+
 // FunctionInstallRequest defines model for FunctionInstallRequest.
 type FunctionInstallRequest struct {
 	// Cid CID of the function
@@ -74,7 +157,48 @@ type FunctionInstallRequest struct {
 
 // FunctionInstallResponse defines model for FunctionInstallResponse.
 type FunctionInstallResponse struct {
+	// Cid CID of the function
+	Cid  string `json:"cid,omitempty"`
 	Code string `json:"code,omitempty"`
+
+	// Done Whether collection of the installation responses is finished
+	Done bool `json:"done,omitempty"`
+
+	// Failed Nodes that failed to install the function
+	Failed []FunctionInstallFailure `json:"failed,omitempty"`
+
+	// NoAnswer Worker Nodes on the topic, known to the Head Node, that did not answer in time
+	NoAnswer []string `json:"no_answer,omitempty"`
+
+	// RequestId ID of the installation request
+	RequestId string `json:"request_id,omitempty"`
+
+	// Succeeded Nodes that installed the function
+	Succeeded []string `json:"succeeded,omitempty"`
+}
+
+// FunctionInstallation Installation of a Bless Function on a Node
+type FunctionInstallation struct {
+	// LastRetrieved Time the Node last used the function
+	LastRetrieved time.Time `json:"last_retrieved,omitempty"`
+
+	// Peer LibP2P ID of the Node
+	Peer string `json:"peer,omitempty"`
+
+	// UpdatedAt Time the function was installed or last updated on the Node
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// FunctionInventory A Bless Function and the Nodes that have it installed
+type FunctionInventory struct {
+	// Cid CID of the function
+	Cid string `json:"cid,omitempty"`
+
+	// Installations Nodes that have the function installed
+	Installations []FunctionInstallation `json:"installations,omitempty"`
+
+	// Name Name of the function, as specified in its manifest
+	Name string `json:"name,omitempty"`
 }
 
 // FunctionResultRequest Get the result of an Execution Request, identified by the request ID
@@ -86,6 +210,52 @@ type FunctionResultRequest struct {
 // FunctionResultResponse defines model for FunctionResultResponse.
 type FunctionResultResponse = ExecutionResponse
 
+// FunctionStatusRequest Get the status of an Execution Request, identified by the request ID
+type FunctionStatusRequest struct {
+	// Id ID of the Execution Request
+	Id string `json:"id"`
+}
+
+// FunctionStatusResponse Status of an Execution Request
+type FunctionStatusResponse struct {
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+
+	// Result Result of the Execution Request, set once the execution is done
+	Result *ExecutionResponse `json:"result,omitempty"`
+
+	// Status Stage the Execution Request is in
+	Status execute.Status `json:"status,omitempty"`
+}
+
+// FunctionUninstallRequest defines model for FunctionUninstallRequest.
+type FunctionUninstallRequest struct {
+	// Cid CID of the function
+	Cid string `json:"cid"`
+
+	// Topic In a scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// FunctionUninstallResponse defines model for FunctionUninstallResponse.
+type FunctionUninstallResponse struct {
+	// Cid CID of the function
+	Cid  string `json:"cid,omitempty"`
+	Code string `json:"code,omitempty"`
+
+	// Failed Nodes that failed to uninstall the function
+	Failed []FunctionInstallFailure `json:"failed,omitempty"`
+
+	// NoAnswer Worker Nodes on the topic, known to the Head Node, that did not answer in time
+	NoAnswer []string `json:"no_answer,omitempty"`
+
+	// RequestId ID of the uninstallation request
+	RequestId string `json:"request_id,omitempty"`
+
+	// Succeeded Nodes that uninstalled the function
+	Succeeded []string `json:"succeeded,omitempty"`
+}
+
 // HealthStatus Node status
 type HealthStatus struct {
 	Code string `json:"code,omitempty"`
@@ -97,20 +267,167 @@ type NamedValue = execute.EnvVar
 // NodeAttributes Attributes that the executing Node should have
 type NodeAttributes = execute.Attributes
 
+// NodeCapacity How much work a Node can take on
+type NodeCapacity = execute.Capacity
+
 // NodeCluster Information about the cluster of nodes that executed this request
 type NodeCluster = execute.Cluster
 
-// ResultAggregation defines model for ResultAggregation.
+// NodeReplacement A Node that failed to execute the request, and the Node that replaced it
+type NodeReplacement = execute.Replacement
+
+// PeerReputation Reputation of a worker node
+type PeerReputation struct {
+	// Disagreements Results that differ from the majority result
+	Disagreements float64 `json:"disagreements,omitempty"`
+
+	// Failures Executions that completed with an error
+	Failures float64 `json:"failures,omitempty"`
+
+	// InvalidSignatures Results with an invalid signature
+	InvalidSignatures float64 `json:"invalid_signatures,omitempty"`
+
+	// LatencyMs Moving average of the time it took the peer to return a result, in milliseconds
+	LatencyMs int64 `json:"latency_ms,omitempty"`
+
+	// NoResults Roll calls the peer accepted but never sent back a result
+	NoResults float64 `json:"no_results,omitempty"`
+
+	// Peer LibP2P ID of the worker node
+	Peer string `json:"peer,omitempty"`
+
+	// Score Reputation score, between 0 and 1. Peers with no recorded failures have the score of 1
+	Score float64 `json:"score,omitempty"`
+
+	// Successes Executions that completed successfully
+	Successes float64 `json:"successes,omitempty"`
+
+	// Timeouts Executions that timed out on the worker
+	Timeouts float64 `json:"timeouts,omitempty"`
+
+	// UpdatedAt Time of the most recent update
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// PipelineInput How the output of the previous step is passed to this step
+type PipelineInput = execute.InputMapping
+
+// PipelineRequest defines model for PipelineRequest.
+type PipelineRequest struct {
+	// Steps Steps of the pipeline, executed in order
+	Steps []PipelineStep `json:"steps"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// PipelineResponse defines model for PipelineResponse.
+type PipelineResponse struct {
+	// Code Status of the pipeline
+	Code string `json:"code,omitempty"`
+
+	// Message If the pipeline failed, this message might have more info about the error
+	Message string `json:"message,omitempty"`
+
+	// Steps Results of the executed steps, in order
+	Steps []ExecutionResponse `json:"steps,omitempty"`
+}
+
+// PipelineStep defines model for PipelineStep.
+type PipelineStep struct {
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// FunctionId CID of the function
+	FunctionId string `json:"function_id"`
+
+	// Input How the output of the previous step is passed to this step
+	Input PipelineInput `json:"input,omitempty"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Optional Continue the pipeline even if this step fails
+	Optional bool `json:"optional,omitempty"`
+
+	// Parameters CLI arguments for the Bless Function
+	Parameters []ExecutionParameter `json:"parameters,omitempty"`
+}
+
+// ResultAggregation How the results of individual executions are aggregated. Results are matched exactly, unless a different strategy is enabled
 type ResultAggregation = execute.ResultAggregation
 
+// RetryPolicy How the head node replaces Nodes that fail to execute the request. Applies to executions without consensus
+type RetryPolicy = execute.RetryPolicy
+
 // RuntimeConfig Configuration options for the Bless Runtime
 type RuntimeConfig = execute.BLSRuntimeConfig
 
+// Schedule Recurring execution managed by the head node
+type Schedule = bls.Schedule
+
+// ScheduleRequest defines model for ScheduleRequest.
+type ScheduleRequest struct {
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// Cron Cron expression describing when the execution runs. Descriptors like `@hourly` or `@every 10m` are supported too
+	Cron string `json:"cron"`
+
+	// FunctionId CID of the function
+	FunctionId string `json:"function_id"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Parameters CLI arguments for the Bless Function
+	Parameters []ExecutionParameter `json:"parameters,omitempty"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// ScheduleRun Outcome of a single scheduled execution
+type ScheduleRun = bls.ScheduleRun
+
+// ListFunctionsParams defines parameters for ListFunctions.
+type ListFunctionsParams struct {
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+}
+
+// GetFunctionParams defines parameters for GetFunction.
+type GetFunctionParams struct {
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+}
+
+// ExecuteBatchJSONRequestBody defines body for ExecuteBatch for application/json ContentType.
+type ExecuteBatchJSONRequestBody = BatchRequest
+
 // ExecuteFunctionJSONRequestBody defines body for ExecuteFunction for application/json ContentType.
 type ExecuteFunctionJSONRequestBody = ExecutionRequest
 
+// ExecuteFunctionStreamJSONRequestBody defines body for ExecuteFunctionStream for application/json ContentType.
+type ExecuteFunctionStreamJSONRequestBody = ExecutionRequest
+
 // InstallFunctionJSONRequestBody defines body for InstallFunction for application/json ContentType.
 type InstallFunctionJSONRequestBody = FunctionInstallRequest
 
+// InstallFunctionAsyncJSONRequestBody defines body for InstallFunctionAsync for application/json ContentType.
+type InstallFunctionAsyncJSONRequestBody = FunctionInstallRequest
+
+// ExecutePipelineJSONRequestBody defines body for ExecutePipeline for application/json ContentType.
+type ExecutePipelineJSONRequestBody = PipelineRequest
+
 // ExecutionResultJSONRequestBody defines body for ExecutionResult for application/json ContentType.
 type ExecutionResultJSONRequestBody = FunctionResultRequest
+
+// ExecutionStatusJSONRequestBody defines body for ExecutionStatus for application/json ContentType.
+type ExecutionStatusJSONRequestBody = FunctionStatusRequest
+
+// UninstallFunctionJSONRequestBody defines body for UninstallFunction for application/json ContentType.
+type UninstallFunctionJSONRequestBody = FunctionUninstallRequest
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = ScheduleRequest
@@ -3,12 +3,34 @@ package api
 import (
 	"context"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/execute"
 )
 
 type Node interface {
 	ExecuteFunction(ctx context.Context, req execute.Request, subgroup string) (code codes.Code, requestID string, results execute.ResultMap, peers execute.Cluster, err error)
-	ExecutionResult(id string) (execute.ResultMap, bool)
-	PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error
+	ExecuteFunctionWithProgress(ctx context.Context, req execute.Request, subgroup string, progress func(execute.Event)) (code codes.Code, requestID string, results execute.ResultMap, peers execute.Cluster, err error)
+	ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string) (requestID string, err error)
+	ExecuteFunctionIdempotent(ctx context.Context, key string, req execute.Request, subgroup string, progress func(execute.Event)) (code codes.Code, requestID string, results execute.ResultMap, peers execute.Cluster, err error)
+	ExecuteFunctionAsyncIdempotent(ctx context.Context, key string, req execute.Request, subgroup string) (requestID string, err error)
+	ExecutePipeline(ctx context.Context, pipeline execute.Pipeline, subgroup string) (code codes.Code, steps []execute.PipelineStepResult, err error)
+	ExecuteBatch(ctx context.Context, batch execute.Batch, subgroup string) (code codes.Code, requestID string, items []execute.BatchItemResult, peers execute.Cluster, err error)
+	ExecuteBatchAsync(ctx context.Context, batch execute.Batch, subgroup string) (requestID string, err error)
+	ExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, bool)
+	ExecutionStatus(ctx context.Context, id string) (execute.Status, bls.ExecutionRecord, bool)
+	CancelExecution(ctx context.Context, id string) (peers []peer.ID, confirmed []peer.ID, err error)
+	CreateSchedule(ctx context.Context, cron string, req execute.Request, subgroup string) (bls.Schedule, error)
+	Schedules(ctx context.Context) []bls.Schedule
+	Reputations() []bls.Reputation
+	PauseSchedule(ctx context.Context, id string, paused bool) (bls.Schedule, error)
+	DeleteSchedule(ctx context.Context, id string) error
+	SignResponse(res *execute.SignedResponse) error
+	InstallFunction(ctx context.Context, uri string, cid string, subgroup string) (bls.FunctionInstall, error)
+	InstallFunctionAsync(ctx context.Context, uri string, cid string, subgroup string) (requestID string, err error)
+	FunctionInstallResult(ctx context.Context, id string) (bls.FunctionInstall, bool)
+	UninstallFunction(ctx context.Context, cid string, subgroup string) (bls.FunctionUninstall, error)
+	FunctionInventory(ctx context.Context, subgroup string) (map[peer.ID][]bls.InstalledFunction, error)
 }
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/node/aggregate"
+)
+
+// ExecutePipeline implements the REST API endpoint for executing a pipeline of functions.
+func (a *API) ExecutePipeline(ctx echo.Context) error {
+
+	err := a.admit(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Unpack the API request.
+	var req PipelineRequest
+	err = ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	pipeline := make(execute.Pipeline, 0, len(req.Steps))
+	for _, step := range req.Steps {
+		pipeline = append(pipeline, execute.PipelineStep{
+			Request: execute.Request{
+				Config:     step.Config,
+				FunctionID: step.FunctionId,
+				Method:     step.Method,
+				Parameters: step.Parameters,
+			},
+			Input:    step.Input,
+			Optional: step.Optional,
+		})
+	}
+
+	err = pipeline.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	code, steps, err := a.Node.ExecutePipeline(ctx.Request().Context(), pipeline, req.Topic)
+	if errors.Is(err, bls.ErrTooManyRequests) {
+		return a.tooManyRequests(ctx, "queue_full", queueFullRetryAfter, err)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not execute pipeline: %w", err))
+	}
+
+	res := PipelineResponse{
+		Code:  code.String(),
+		Steps: make([]ExecutionResponse, 0, len(steps)),
+	}
+
+	for i, step := range steps {
+		aggregation := pipeline[i].Config.ResultAggregation
+		stepRes := ExecutionResponse{
+			Code:        step.Code.String(),
+			RequestId:   step.RequestID,
+			Message:     step.Message,
+			Results:     aggregate.Aggregate(step.Results, aggregation),
+			Aggregation: aggregation.Strategy(),
+			Cluster:     step.Cluster,
+		}
+		a.signResponse(&stepRes)
+
+		res.Steps = append(res.Steps, stepRes)
+	}
+
+	if code != codes.OK && len(steps) > 0 {
+		res.Message = fmt.Sprintf("pipeline stopped at step %d", len(steps)-1)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
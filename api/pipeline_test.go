@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_ExecutePipeline(t *testing.T) {
+
+	steps := []api.PipelineStep{
+		{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		},
+		{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Input:      execute.InputMapping{Type: execute.InputStdin},
+		},
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutePipelineFunc = func(_ context.Context, pipeline execute.Pipeline, _ string) (codes.Code, []execute.PipelineStepResult, error) {
+
+			require.Len(t, pipeline, len(steps))
+			require.Equal(t, execute.InputStdin, pipeline[1].Input.Type)
+
+			return codes.Error, []execute.PipelineStepResult{{RequestID: mocks.GenericUUID.String(), Code: codes.Error}}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(pipelineEndpoint, api.PipelineRequest{Steps: steps})
+		require.NoError(t, err)
+
+		err = srv.ExecutePipeline(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.PipelineResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, codes.Error.String(), res.Code)
+		require.NotEmpty(t, res.Message)
+		require.Len(t, res.Steps, 1)
+		require.Equal(t, mocks.GenericUUID.String(), res.Steps[0].RequestId)
+	})
+	t.Run("first step cannot have input", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(pipelineEndpoint, api.PipelineRequest{Steps: steps[1:]})
+		require.NoError(t, err)
+
+		err = srv.ExecutePipeline(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node rejects pipeline", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutePipelineFunc = func(context.Context, execute.Pipeline, string) (codes.Code, []execute.PipelineStepResult, error) {
+			return codes.TooManyRequests, nil, bls.ErrTooManyRequests
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(pipelineEndpoint, api.PipelineRequest{Steps: steps})
+		require.NoError(t, err)
+
+		err = srv.ExecutePipeline(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+	})
+}
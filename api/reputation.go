@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+// ListReputation implements the REST API endpoint for listing the reputation of worker nodes.
+func (a *API) ListReputation(ctx echo.Context) error {
+
+	reputations := a.Node.Reputations()
+
+	res := make([]PeerReputation, 0, len(reputations))
+	for _, reputation := range reputations {
+		res = append(res, peerReputation(reputation))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func peerReputation(r bls.Reputation) PeerReputation {
+
+	return PeerReputation{
+		Peer:              r.Peer.String(),
+		Score:             r.Score(),
+		Successes:         r.Successes,
+		Failures:          r.Failures,
+		Timeouts:          r.Timeouts,
+		NoResults:         r.NoResults,
+		Disagreements:     r.Disagreements,
+		InvalidSignatures: r.InvalidSignatures,
+		LatencyMs:         r.Latency.Milliseconds(),
+		UpdatedAt:         r.UpdatedAt,
+	}
+}
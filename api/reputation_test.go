@@ -0,0 +1,63 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_ListReputation(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(reputationEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListReputation(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res []api.PeerReputation
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res, 1)
+
+		reputation := mocks.GenericReputation
+
+		require.Equal(t, reputation.Peer.String(), res[0].Peer)
+		require.Equal(t, reputation.Score(), res[0].Score)
+		require.Equal(t, reputation.Successes, res[0].Successes)
+		require.Equal(t, reputation.NoResults, res[0].NoResults)
+		require.Equal(t, reputation.Latency.Milliseconds(), res[0].LatencyMs)
+		require.True(t, reputation.UpdatedAt.Equal(res[0].UpdatedAt))
+	})
+	t.Run("no known peers", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ReputationsFunc = func() []bls.Reputation {
+			return nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(reputationEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListReputation(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res []api.PeerReputation
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Empty(t, res)
+		require.NotNil(t, res)
+	})
+}
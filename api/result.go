@@ -6,6 +6,9 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/node/aggregate"
 )
 
 func (r FunctionResultRequest) Valid() error {
@@ -33,11 +36,30 @@ func (a *API) ExecutionResult(ctx echo.Context) error {
 	}
 
 	// Lookup execution result.
-	result, ok := a.Node.ExecutionResult(request.Id)
+	record, ok := a.Node.ExecutionResult(ctx.Request().Context(), request.Id)
 	if !ok {
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
+	res := executionResponseFromRecord(record)
+	a.signResponse(&res)
+
 	// Send the response back.
-	return ctx.JSON(http.StatusOK, result)
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// executionResponseFromRecord transforms the execution record to the format returned by the API.
+func executionResponseFromRecord(record bls.ExecutionRecord) ExecutionResponse {
+
+	res := ExecutionResponse{
+		Code:        string(record.Code),
+		RequestId:   record.RequestID,
+		Message:     record.Message,
+		Results:     aggregate.Aggregate(record.Results, record.Request.Config.ResultAggregation),
+		Aggregation: record.Request.Config.ResultAggregation.Strategy(),
+		Cluster:     record.Cluster,
+		Batch:       record.Batch,
+	}
+
+	return res
 }
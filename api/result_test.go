@@ -1,15 +1,17 @@
 package api_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Maelkum/b7s/api"
-	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/testing/mocks"
 )
 
@@ -29,17 +31,25 @@ func TestAPI_ExecutionResult(t *testing.T) {
 		err = srv.ExecutionResult(ctx)
 		require.NoError(t, err)
 
-		var res execute.ResultMap
+		var res api.FunctionResultResponse
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
 
 		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
-		require.Equal(t, mocks.GenericExecutionResultMap, res)
+
+		record := mocks.GenericExecutionRecord
+		require.Equal(t, string(record.Code), res.Code)
+		require.Equal(t, record.RequestID, res.RequestId)
+		require.Equal(t, record.Cluster, res.Cluster)
+
+		require.Len(t, res.Results, 1)
+		require.Equal(t, mocks.GenericExecutionResult.Result, res.Results[0].Result)
+		require.Equal(t, []peer.ID{mocks.GenericPeerID}, res.Results[0].Peers)
 	})
 	t.Run("response not found", func(t *testing.T) {
 
 		node := mocks.BaselineNode(t)
-		node.ExecutionResultFunc = func(id string) (execute.ResultMap, bool) {
-			return execute.ResultMap{}, false
+		node.ExecutionResultFunc = func(context.Context, string) (bls.ExecutionRecord, bool) {
+			return bls.ExecutionRecord{}, false
 		}
 
 		srv := api.New(mocks.NoopLogger, node)
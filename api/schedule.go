@@ -0,0 +1,88 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// CreateSchedule implements the REST API endpoint for creating an execution schedule.
+func (a *API) CreateSchedule(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req ScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	schedule := bls.Schedule{
+		Cron: req.Cron,
+		Request: execute.Request{
+			Config:     req.Config,
+			FunctionID: req.FunctionId,
+			Method:     req.Method,
+			Parameters: req.Parameters,
+		},
+		Topic: req.Topic,
+	}
+
+	err = schedule.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	created, err := a.Node.CreateSchedule(ctx.Request().Context(), schedule.Cron, schedule.Request, schedule.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not create schedule: %w", err))
+	}
+
+	return ctx.JSON(http.StatusCreated, created)
+}
+
+// ListSchedules implements the REST API endpoint for listing execution schedules.
+func (a *API) ListSchedules(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, a.Node.Schedules(ctx.Request().Context()))
+}
+
+// PauseSchedule implements the REST API endpoint for pausing an execution schedule.
+func (a *API) PauseSchedule(ctx echo.Context, id string) error {
+	return a.pauseSchedule(ctx, id, true)
+}
+
+// ResumeSchedule implements the REST API endpoint for resuming a paused execution schedule.
+func (a *API) ResumeSchedule(ctx echo.Context, id string) error {
+	return a.pauseSchedule(ctx, id, false)
+}
+
+func (a *API) pauseSchedule(ctx echo.Context, id string, paused bool) error {
+
+	schedule, err := a.Node.PauseSchedule(ctx.Request().Context(), id, paused)
+	if errors.Is(err, bls.ErrNotFound) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not update schedule: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
+
+// DeleteSchedule implements the REST API endpoint for deleting an execution schedule.
+func (a *API) DeleteSchedule(ctx echo.Context, id string) error {
+
+	err := a.Node.DeleteSchedule(ctx.Request().Context(), id)
+	if errors.Is(err, bls.ErrNotFound) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not delete schedule: %w", err))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
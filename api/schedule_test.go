@@ -0,0 +1,207 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_CreateSchedule(t *testing.T) {
+
+	req := api.ScheduleRequest{
+		Cron:       "*/15 * * * *",
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Topic:      "dummy-topic",
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CreateScheduleFunc = func(_ context.Context, cron string, exr execute.Request, subgroup string) (bls.Schedule, error) {
+
+			require.Equal(t, req.Cron, cron)
+			require.Equal(t, req.FunctionId, exr.FunctionID)
+			require.Equal(t, req.Topic, subgroup)
+
+			return mocks.GenericSchedule, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Result().StatusCode)
+
+		var res api.Schedule
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, mocks.GenericSchedule, res)
+	})
+	t.Run("invalid cron expression", func(t *testing.T) {
+		t.Parallel()
+
+		req := req
+		req.Cron = "every now and then"
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(scheduleEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node fails to create schedule", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CreateScheduleFunc = func(context.Context, string, execute.Request, string) (bls.Schedule, error) {
+			return bls.Schedule{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(scheduleEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_ListSchedules(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	rec, ctx, err := setupRecorder(scheduleEndpoint, nil)
+	require.NoError(t, err)
+
+	err = srv.ListSchedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var res []api.Schedule
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, []api.Schedule{mocks.GenericSchedule}, res)
+}
+
+func TestAPI_PauseSchedule(t *testing.T) {
+	t.Run("schedule paused", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.PauseSchedule(ctx, mocks.GenericSchedule.ID)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.Schedule
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.True(t, res.Paused)
+	})
+	t.Run("schedule resumed", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ResumeSchedule(ctx, mocks.GenericSchedule.ID)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.Schedule
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.False(t, res.Paused)
+	})
+	t.Run("schedule not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PauseScheduleFunc = func(context.Context, string, bool) (bls.Schedule, error) {
+			return bls.Schedule{}, bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.PauseSchedule(ctx, "dummy-schedule-id")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+}
+
+func TestAPI_DeleteSchedule(t *testing.T) {
+	t.Run("schedule deleted", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.DeleteSchedule(ctx, mocks.GenericSchedule.ID)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, rec.Result().StatusCode)
+	})
+	t.Run("schedule not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.DeleteScheduleFunc = func(context.Context, string) error {
+			return bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.DeleteSchedule(ctx, "dummy-schedule-id")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("node fails to delete schedule", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.DeleteScheduleFunc = func(context.Context, string) error {
+			return mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(scheduleEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.DeleteSchedule(ctx, "dummy-schedule-id")
+		require.Error(t, err)
+	})
+}
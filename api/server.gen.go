@@ -8,28 +8,78 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List installed Bless Functions
+	// (GET /api/v1/functions)
+	ListFunctions(ctx echo.Context, params ListFunctionsParams) error
+	// Execute a Bless Function for a batch of inputs
+	// (POST /api/v1/functions/batch)
+	ExecuteBatch(ctx echo.Context) error
 	// Execute a Bless Function
 	// (POST /api/v1/functions/execute)
 	ExecuteFunction(ctx echo.Context) error
+	// Execute a Bless Function and stream execution progress
+	// (POST /api/v1/functions/execute/stream)
+	ExecuteFunctionStream(ctx echo.Context) error
 	// Install a Bless Function
 	// (POST /api/v1/functions/install)
 	InstallFunction(ctx echo.Context) error
+	// Install a Bless Function asynchronously
+	// (POST /api/v1/functions/install/async)
+	InstallFunctionAsync(ctx echo.Context) error
+	// Get the outcome of an asynchronous Bless Function installation
+	// (GET /api/v1/functions/install/{id})
+	GetFunctionInstall(ctx echo.Context, id string) error
+	// Execute a pipeline of Bless Functions
+	// (POST /api/v1/functions/pipeline)
+	ExecutePipeline(ctx echo.Context) error
 	// Get the result of an Execution Request
 	// (POST /api/v1/functions/requests/result)
 	ExecutionResult(ctx echo.Context) error
+	// Get the status of an Execution Request
+	// (POST /api/v1/functions/requests/status)
+	ExecutionStatus(ctx echo.Context) error
+	// Cancel an Execution Request
+	// (DELETE /api/v1/functions/requests/{id})
+	CancelExecution(ctx echo.Context, id string) error
+	// Uninstall a Bless Function
+	// (POST /api/v1/functions/uninstall)
+	UninstallFunction(ctx echo.Context) error
+	// Get installations of a Bless Function
+	// (GET /api/v1/functions/{cid})
+	GetFunction(ctx echo.Context, cid string, params GetFunctionParams) error
 	// Check Node health
 	// (GET /api/v1/health)
 	Health(ctx echo.Context) error
+	// List worker reputation
+	// (GET /api/v1/peers/reputation)
+	ListReputation(ctx echo.Context) error
+	// List execution schedules
+	// (GET /api/v1/schedules)
+	ListSchedules(ctx echo.Context) error
+	// Create an execution schedule
+	// (POST /api/v1/schedules)
+	CreateSchedule(ctx echo.Context) error
+	// Delete an execution schedule
+	// (DELETE /api/v1/schedules/{id})
+	DeleteSchedule(ctx echo.Context, id string) error
+	// Pause an execution schedule
+	// (POST /api/v1/schedules/{id}/pause)
+	PauseSchedule(ctx echo.Context, id string) error
+	// Resume an execution schedule
+	// (POST /api/v1/schedules/{id}/resume)
+	ResumeSchedule(ctx echo.Context, id string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -37,6 +87,33 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ListFunctions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFunctions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFunctionsParams
+	// ------------- Optional query parameter "topic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topic", ctx.QueryParams(), &params.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFunctions(ctx, params)
+	return err
+}
+
+// ExecuteBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteBatch(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteBatch(ctx)
+	return err
+}
+
 // ExecuteFunction converts echo context to params.
 func (w *ServerInterfaceWrapper) ExecuteFunction(ctx echo.Context) error {
 	var err error
@@ -46,6 +123,15 @@ func (w *ServerInterfaceWrapper) ExecuteFunction(ctx echo.Context) error {
 	return err
 }
 
+// ExecuteFunctionStream converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteFunctionStream(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteFunctionStream(ctx)
+	return err
+}
+
 // InstallFunction converts echo context to params.
 func (w *ServerInterfaceWrapper) InstallFunction(ctx echo.Context) error {
 	var err error
@@ -55,6 +141,40 @@ func (w *ServerInterfaceWrapper) InstallFunction(ctx echo.Context) error {
 	return err
 }
 
+// InstallFunctionAsync converts echo context to params.
+func (w *ServerInterfaceWrapper) InstallFunctionAsync(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstallFunctionAsync(ctx)
+	return err
+}
+
+// GetFunctionInstall converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFunctionInstall(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFunctionInstall(ctx, id)
+	return err
+}
+
+// ExecutePipeline converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecutePipeline(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecutePipeline(ctx)
+	return err
+}
+
 // ExecutionResult converts echo context to params.
 func (w *ServerInterfaceWrapper) ExecutionResult(ctx echo.Context) error {
 	var err error
@@ -64,6 +184,65 @@ func (w *ServerInterfaceWrapper) ExecutionResult(ctx echo.Context) error {
 	return err
 }
 
+// ExecutionStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecutionStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecutionStatus(ctx)
+	return err
+}
+
+// CancelExecution converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelExecution(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelExecution(ctx, id)
+	return err
+}
+
+// UninstallFunction converts echo context to params.
+func (w *ServerInterfaceWrapper) UninstallFunction(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UninstallFunction(ctx)
+	return err
+}
+
+// GetFunction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFunction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "cid" -------------
+	var cid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cid", ctx.Param("cid"), &cid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFunctionParams
+	// ------------- Optional query parameter "topic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topic", ctx.QueryParams(), &params.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFunction(ctx, cid, params)
+	return err
+}
+
 // Health converts echo context to params.
 func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
 	var err error
@@ -73,6 +252,81 @@ func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
 	return err
 }
 
+// ListReputation converts echo context to params.
+func (w *ServerInterfaceWrapper) ListReputation(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListReputation(ctx)
+	return err
+}
+
+// ListSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSchedules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSchedules(ctx)
+	return err
+}
+
+// CreateSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSchedule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSchedule(ctx)
+	return err
+}
+
+// DeleteSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSchedule(ctx, id)
+	return err
+}
+
+// PauseSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) PauseSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PauseSchedule(ctx, id)
+	return err
+}
+
+// ResumeSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) ResumeSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResumeSchedule(ctx, id)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -101,57 +355,163 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api/v1/functions", wrapper.ListFunctions)
+	router.POST(baseURL+"/api/v1/functions/batch", wrapper.ExecuteBatch)
 	router.POST(baseURL+"/api/v1/functions/execute", wrapper.ExecuteFunction)
+	router.POST(baseURL+"/api/v1/functions/execute/stream", wrapper.ExecuteFunctionStream)
 	router.POST(baseURL+"/api/v1/functions/install", wrapper.InstallFunction)
+	router.POST(baseURL+"/api/v1/functions/install/async", wrapper.InstallFunctionAsync)
+	router.GET(baseURL+"/api/v1/functions/install/:id", wrapper.GetFunctionInstall)
+	router.POST(baseURL+"/api/v1/functions/pipeline", wrapper.ExecutePipeline)
 	router.POST(baseURL+"/api/v1/functions/requests/result", wrapper.ExecutionResult)
+	router.POST(baseURL+"/api/v1/functions/requests/status", wrapper.ExecutionStatus)
+	router.DELETE(baseURL+"/api/v1/functions/requests/:id", wrapper.CancelExecution)
+	router.POST(baseURL+"/api/v1/functions/uninstall", wrapper.UninstallFunction)
+	router.GET(baseURL+"/api/v1/functions/:cid", wrapper.GetFunction)
 	router.GET(baseURL+"/api/v1/health", wrapper.Health)
+	router.GET(baseURL+"/api/v1/peers/reputation", wrapper.ListReputation)
+	router.GET(baseURL+"/api/v1/schedules", wrapper.ListSchedules)
+	router.POST(baseURL+"/api/v1/schedules", wrapper.CreateSchedule)
+	router.DELETE(baseURL+"/api/v1/schedules/:id", wrapper.DeleteSchedule)
+	router.POST(baseURL+"/api/v1/schedules/:id/pause", wrapper.PauseSchedule)
+	router.POST(baseURL+"/api/v1/schedules/:id/resume", wrapper.ResumeSchedule)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW2/bOhL+K4R2H2U7cW44fkvTdBtsT5ttFi12DwKDkkYSa4pUScqJG/i/L3jRxZbs",
-	"2I5T9yzOU1OaIj/OfHPhDJ+8kGc5Z8CU9EZPngxTyLD58zJJBCRYQfQZZEGVHotAhoLkinDmjTw7jniM",
-	"MEPXjxAW+gf0Gb4XIJXne7ngOQhFwCwYC/0DC2ftld6VP+nFVEokEnZtnHGWIEwpYjwCiVSKFQKzFURI",
-	"pYBEtRs84iyn4I2O+ufnvqdmOXgjjxVZAMLzvcdewntuMKYcq/PT5mhPTkje4wYRpr2cE6ZAeCMlCpj7",
-	"Xg4gZBv4BxLkwxzdvJUWOaCPNc6Eq+ZhmhD/8I6Hb0/+yfnXz/nJ5ZfJxXcVDi+n54/ke3L5Ax//lxcT",
-	"+S/8n/BuGE4//nY6eX93xbHn7/JZ4N37HlGQGfxOAlIJwhJvXskJC4FnWwhEVKT4u4DYG3l/G9RUGjge",
-	"DSpWOA7N6w158A1CtaQYXJKu/7mUWQ2IZDkXZsscq9QbeQlRaRH0Q54NAgpSMlAPXEwGwYUcaL4MquX0",
-	"QTc92TLxO9UuDe8LRr4X4PRbUaDLFCr5r5NWy+TWqKdDWPIg0lJKkKBQcKkUSMW7rESLgQhAMoeQxCRE",
-	"uJyLsERTXoSptq5lhwE4TDtN7nZ4i24BRGl3eiLKMIuw4mJWrd4U++Esb18GxxmMebyRPGrxPqQgAD1Y",
-	"N6lVgBWigDV7Gfw/OaRn/IoLGf0Otu5sMxmPgMqBW3obm6kcxBVnMUnaOrXjhcDGi9h1JIq5WOlfFi0H",
-	"l8d81uXocHVZz577XsiZBCYLOcY04YKoNGsD/JqSMEXVVFRNRTLlBY1QABpuBpFDTaQL2/r7Bu+8PIg1",
-	"/kUWbC5KYNPxFHc5nWs2JYKzDJhCUywI1gqsZPhGaxO9K1joEG3koD/iDKIvmBbwAkO2GcmYx2OT07SR",
-	"fzQTtCE3kh4nV8e27jNUUj2uwOmtk8X859lMR2RESs24NrTb+sc2HTv9rZcqlcvRYIBz0nej2p48f8+p",
-	"yLgMXwbpej3aeHnZ+EAvUzBFMnj2WzvNme7c96SKCGuL6k7pgCQidMPyQq0mXi2pTb/Y1VZUKkCmnEYd",
-	"euXCuhqbwbR5J0DmnEXogagU4TI9V9z4ABLBso0jWYQhSBkXtJuY7bz8OfQkA150XEPe8wdE9TXBQdUH",
-	"qHEoPAFvZ3PYMKw4MhwylNxigTMwPz0tRYOpcVitULuFDFz2EOk8wa52v5lgalSHlE0ZJluSCav4u9El",
-	"prb52FnjmHQY09XN29KQ4i47D3A8C4Dg4en0NPyBpyr/Nh2G/OTb2Sk/xWc/VFR8D/PZjDAQ3xIWPl7I",
-	"oRwO5QXgF1h/BirlHWh1TCvhfr28+x3FhIK27FLiTegpUMp7D1zQqP+AZfYCPHlJjY4wc/XhBmGRFDp4",
-	"yw1c5x8Vyb1eL6SkF1OcHHtzvx43/y4O1VOH7alDb36/YVbQYYO7BzPFcxK2JXLDjAhkCAwLwsvEnosJ",
-	"CCOhDMkiSAQvcumjGS9QiLXzEwkohOubVzkJBTM3OCMsQURJRCJgisTEGGut8p1VvOQ3miZTsXGdG9nc",
-	"unVoktBh3rSQziM+lwNfuakmAY6gM5yrorrid+eyw6OjFxmolDjp2PpmRVkBxZhQiHwbet3nKCNJqlCK",
-	"p4AyLgARFnOEA14oi1wIczPeFaWrt3U6vtrvdV1RGg7wPA6C8Ax6x9Hxee8U8G+94Ozsond2HJ/icxyc",
-	"nZ+FL4JYlW22qbbI9dWpLejYWS29DFWBKeKFygvVJpKPKJkAqnLAT2aeXw9ca8X56PqRKHTFI0Cgwn6/",
-	"XTR5JGrcTWHzqf6pi8W7CluqCIRYk/4a3HvesTMPXBLd/rbcMAl0FwO7+yHynTIy3jCpMKWrs54/T9Ky",
-	"OhriP1Us9L1CkMV78b7iqtbmC8JoizQrg6nzKfsJd/OXI7aOtsHyRY78A5RrEK3rUvm1qiPNg0ZLCd28",
-	"bXnXXzbqLZFiP5woJfxXfvVXfnXo/Oo9YKpSy5KOS6zOaqT90f9VHVejetzODtEEZj1z80Q5JqJ1Coaz",
-	"pVOYkd21WFWF6hXt0J5ckIO3Vbnomk2/4IPUipb6IG39VL/ZsmjDSbEEWfrZ8qP2DF0NGdD01PffWkgt",
-	"+5aIKMQg1K5GzOqdzPp1UwdhAa5tavsrwUwHt0bfs9JpjKmESvgB5xQw24IluNnVXWvt7c5aSTIrAUo/",
-	"xaZS87xgW+Kc+0+bIt68gXO/ddNQHoqZV3WgXU6Edbpr24R1DHJxeamD1Hg2Y96kdLcOM9zVzHBN5joA",
-	"3QqSaYrq9asCXZkPNGPRjm3lnauKK9/pWPxL73QIs9KokR/6xcBOn4X7e2iwacejEthPN4h2866VmALD",
-	"AW22PLZ3fIvl6W2bwk+vXGpoieAQelhohG75hsHW8t0SLScUQVAkY51Zv0iHkSBTEHIsOFdjK4unF7w0",
-	"UGK21NreXzckLoA20G3ftqc8SWyE2P1+lHHR8TjzdzOOKMmI6n7NsTNoUbBx2XP/5Zq6bz7cLVL8p9uY",
-	"RgqPCgTD9C0PO+LaO8IipMO+uYraDODuASdWHIWg7gHGaDCQdrhPuAZQ2tbicv/WmiUSvbm4Q+8BRzYT",
-	"uwMxBYECLCFC3DaiPuXALm9v0En/qCqoGUvva70QZexDL2NW+Kxv03p6r/mhvh2AkHbro/5p/zeNjOfA",
-	"cE68kXfSP+qfaN+AVWrOPsA5GUyPB2WBshap1gPvqgJdu/cyuN091A7HQL6J6omN312G9IZHM9crVsDM",
-	"FjjPqTvu4Ju0AchGgy3ew9r0y+h4Y8j11aG+ipvajBGPvta+AlBX/elAele98Wi4g7nvnVogy6nqFFMS",
-	"Nay4TEHnvnfW/YWlPpKWgLa0omHIItPZ53phKZzIZuNReibjb5OI2NLnahK52ujzJHITX5lEK+r8HQpa",
-	"A/znUWlVhXk1XtykB8LhhPEHClEC0ZL215xvY+27bfQfZeOumwWbFZRXOJa6Mfi6nFgsis8XizI/S9NL",
-	"deOVPs5q2UhTgBIEplrDz/iPfXiNjVX5DItSU5TUGBLoYMxVCuHERkA3c5kc78vhV9PJQt20QxMGHZEO",
-	"4GxJUF0nKGXiBu7Nom6wFX6nIGYqJSxxycminUpvqwRnIaWRo4HNtPplqhXxUA7cfzQ9bMm0obu5v7z8",
-	"FxAkdlUMex6EWYTwFBOKA0KJ0jdnt5A78Px+/r8AAAD//848wzE3NQAA",
+	"H4sIAAAAAAAC/+x9e3PkNnL4V0Hx96tKcsUZPfbhWPnn9nne5LxWJJ9dya1LwpA9M7BIgAuAksYuffcU",
+	"ngRJcN6Sdm3VVZ1XHBBoAt2NfvfvScbKilGgUiQnvycim0OJ9T9fzWYcZlhCfgaiLqR6loPIOKkkYTQ5",
+	"ScxzxKYIU/TuFrJa/YDO4HMNQiZpUnFWAZcE9IRTrn6g2aI/03v3k5pMzolA3MyNS0ZnCBcFoiwHgeQc",
+	"SwR6KciRnAPifjW4xWVVQHJyOH75Mk3kooLkJKF1OQGepMntaMZG9uG0YFi+fB4+HYkrUo2YhggXo4oR",
+	"KoEnJ5LXcJcmFQAXfcD/TibVcYU+vBUGckAfGzhnTIYfE4L4z+To+O2z/2Ls57Pq2aufrr75LLPjV9cv",
+	"b8nn2avf8NH/svpK/Df+n+z8OLv++O3zq+/O3zCcpNu8Nkl+SRMiodTw2x0QkhM6S+78PmHO8WKDDeEe",
+	"Kf4/h2lykvy/gwaVDiweHXissDh01yzIJr9CJjsHgx3Sjc/cnjUAkbJiXC9ZYTlPTpIZkfN6Ms5YefA9",
+	"huKqLg8m34gDhSoHfqbkLpxj+Ud1cT564kKjfE3J5xrs0frTj1GB3/plG9VdednJRPZJPPRGScnJpJbw",
+	"SkoQksVoQ+0A4YBEBRmZkgxhNxZhga5Znc0VTXXZBOBsHiW00+NTdArAHbWpgajENMeS8YWfPdzxx6O3",
+	"fZEZo3DBpmvtR7O9N3PggG4Mc1RHgCUqACvEpfBHYkMruIm9KMYRbN2GXEqWQyEO7KxbkYuliRKoHCQY",
+	"9SOaMo4wEoTOCnOnIOzm6FEMxSX0Z/uIS3BsKXzXH37CcZl0MXUDzKyAK8rrr/yGlRXmRDCK/KA0AVqX",
+	"CuPgc5ImVP//TOr/U1BpVl/ofxKqvxCm5DZJEw4zuE1+CeE2b2wL9zUu6sh2/aQeI8lQpqEHhGeYUCH/",
+	"A9G6BE4yfSQzmaKZhBQp2YTmqFD/xojDrC4wR3BbcRBC8X812sAegn70clfII4z2HIwQlmVQKbHIjNMQ",
+	"qMMn7WNoaB/q0Y0VnepRBlRyXNwPkVo+lKtVNbYG6PPLhjQcktDDUvFrLLP5Bwll/wg+0KqWok214GUB",
+	"QhFGE/V2/7aj1xfXOHZ9vqPXhDOqucE15gRPChApwnmuJF+mjxZiYxzR6wUD+XgtOURxjVzTQu+8lRSM",
+	"OS5BRkXhN3//gDCf1QoYBWdVAQ1A9T/tBJ6Xr04dJDEwhcwJjdCJVMICz5E+rRSxa+CcGHEdUPvXISg7",
+	"JLHmBdRgziNh7Boq3EZom7Ecohssa3/AfqIxeldWcoGIea4OGt1ggSgLdLkFtBSk5PjwMImIUSUIgWeR",
+	"tT90FkVTTArIU6OB2ddQSWZzieb4GlDJuOKNU4bwhNXSvM0547FlKwA+KIR9eBsqfz0dlQiLRcQgQHAb",
+	"bCd89aCz6HlB8si+vO2dh1eyB8CavJxOJtkLGB3lRy9HzwF/O5q8ePHN6MXR9Dl+iScvXr7I4mDch0rY",
+	"o6EdNMMdKcnpdCe/d8gBiwXNYtQla05DM4XCFVKWkBMsoVhoGYLXNGA1xP2RXc04q2k+RnptVHE2U8KF",
+	"eceqnRmmaKJml5zANeSoVlTcWTE83CkuBPgNnzBWAKYbyCAZo1MyW/uM35jhd2kyrWmmnkSR9E2DpW5c",
+	"GyPxdDEBgo+fXz/PfsPXsvr1+jhjz3598Zw9xy9+k3n9OasWC0KB/zqj2e034lgcH4tvAO8gb/kbafCu",
+	"N+JVTq5JXuOioTB1/0lUMiHR0aFmZGvdbs01sb3CWIKcs3y5OvDzq/Pv0ZQUWt51xBDu9xyKgo1uGC/y",
+	"8Q0Wu6gJa4sMfkNfFwrL31s8SJGYYw45miy0HbDhWWKPQsP63yNZRbIYUmjYRQYUc8KcCs74FXD9aSUS",
+	"9URRdCVStGC1Jl2J+Qwkwo2NxA1Sn2seLhRBEykQyYFKMiXQEuOTrc+mI5eHBOrRyO3wMiF9xYZ1eUFE",
+	"XVTPa47N3aQfN9iw2q6MZxy0OnAh5xzEnBUR9D9lvLn6GrOdvqvFnNVFjvQ8KcJTCdzbn61xTCOiVg6p",
+	"IDlwc382N6qoswyEmNbF+BP9MEVTuPGTCDdz+xLWdmF1bUGOboicIyVToeeH347RWwZGOsJVVSwaItUb",
+	"o8eevn7/owYGqKjF+BP9WT11muq/6guGIsZRCZj+W/szHFRaCpIMlZqi4BZn0iymwd3AoL4CAbzxYbXy",
+	"wXJ41Yy+S5PJosJCXGQ4m0dkPoMb0Lo3EFwDdaLmHHCuPQdojgXCSM/jbk+jrVFLVxku3JU5/mRlE4Ew",
+	"B/cSo8VCv+JWEqjE/ApyZc3UY5T2Ze9vwpVlkkw7nok93L7mxC9wMWOcyHlEF/15TrJ5gxzID3WIPgHN",
+	"kCC3RBZicghtUk2mcgfWv6FuO3AB7EFz3QDk26yoc7gYcPcEzh27l4EOM+SO+ud9uF8I3RDQCJBj9GGK",
+	"BMgUcVYUKFPXKxFIqFPJCQfHD+QchHNsESqkIio2RRNQN1NVTwoi5pCnnyimlkzM2ClnpUGvggipaakW",
+	"FuuiCLelOfo+9tdwuQs2vdCOx8gO6wFqH+jSve4hs//aIw+cWnq2EU+tgJdEGxojoJ02P/av0ah7JJlL",
+	"WYmTgwNckbF9qpSnJN2zv/AiuIlWEbThwa+CF/Q0ki9Wvyn54pQVJNPmIF5TSUpY+ZYZ1qgsAgowIpGQ",
+	"HEuYLWImD/OLwWwlIcwZE2Ad12rnA/Tw9702DjuaC2zjU8KFHGVMW0g5prk+Au26GRUM55CrP5my2o4K",
+	"LLUzvWUa7wzdlnOvZUAb5tcNPOu+sS2g60t8fSrlICpGrfCFnVCwlpAXJ+ONJSOFbqyOmOW+YzeoUAhk",
+	"QW1LjhJfBQ6QTZnHmuYWSwYPa2WJaGo9W4v33+xF6zGzremDaKB6pG25XwNUy/LbNUI1epgwVt7GDPVk",
+	"hbpXK1QOZcU0r7+4gsgV9KYgQOUomzMBFF3BwlkKFp1DGKMzfUK5e2CVSTVEKPOQelcd64TlC5QzLdwK",
+	"ibmyUFC4CRBk9InKOSzQr4xEcMebKrUPVKMgkS4MSkHR4Ida0jLgnEynwIFKjzQaDq0nK6KEvHUajOfA",
+	"R0fHz57vsLl/NGtZS5r2vDIZjbKCjKYFnh0ld2nzXP+3/agZetwfepzc/fJkdLtvo9s+jG1nWrgRELkq",
+	"2hJ41B8YjEFO9tXGKNI4dLkPPGu2RRuRdkB/42wcgKrjOdZYmGo6mFgLlv12MUY/KOZgTDOdNza2xa+O",
+	"x1t1WRW1sJLMKuPXGzv0Lt3Mw7rSc7o+tMpktoans7GsGZGazKgPyLWYt3dP5yZsfbmXuGdU3qO3eBOV",
+	"eB23bcwAvqOvdlOtfePoVW27VTiBZc1jaOx+CuzxGmlSVOIcAvmxwbMrWIzRGxWzITqSZapN596wnSJL",
+	"cUaW0aQ/JVDkyk7+PUicY4m7jgAiUMauHc8gUgcoCOAEF+Q3yJXQYiB0wu41cHUthLLu5RUspozP4BLV",
+	"khRELna5JvZxBURjPl5lUjkrWS21H7PLS1JUkKsgHOYHPS5tHrxTaJ+id7dEojfqZEBm43E/sumWyIs4",
+	"F9Ovqp9ijGwHmwVwvsRooeHe84pR7b2zdftbck3V3RqyzOoPrKo6QfQNphkUoRTS3qMfapkxI2PHkkcU",
+	"lWVQFNjuVzf6iE6JcmAsuahiCRn+ve70X6IFejjjZPgDb5z8q1gSo6vvjz9CHsoXfonuwModMX2gQuKi",
+	"eKWMPMOC/Xo7QcxcRrbnX+9mvMekiMoW77Uk1/5OxlFN209UxGNbd1YEgzU19e8yLen17zH1uLHqTxae",
+	"HncxAawfavh4Ivb+znHQpJl9PSa6YfME/qqME2lSc9J2DO7L0JGRnewaPaQZYoJfEdY42XhfmnvOKMSi",
+	"QUDOgaOMFdabOXATmB3VmtCUUO3ST3Yw0RuFemlkghmCJHOgdE9oLUPNwL2wg+ufXWAqbmJc+GdNvVbi",
+	"svKVJv4UXVF2Q51t7DultX7USqn+0pyYSBEzr3avkBLCL3xYcehxhYA00Z5UyFfgh4US8kG8uP8sws34",
+	"0oBV9cP2kkeBhbzwjrX+1D+SEprUAzXaRiK0d0xdN1gmJ0mOJYws8v2hJZQ0qSv1sfkFlku2ze2Rtvc0",
+	"CMe43Uszh6N1+0l73M294N41UMl4xDX4qotoyiLW0Rm1eZME1NZXt78iZ2lAacvD4vRntzAg3IBtbh4f",
+	"o7TtvbMyjXfqY+KxsLIg0aqOlgZjAaehb/IxcdTYIgNZv/2RfwMZWGOHjEJpI/B6ZasVX9BG2y/ZIhCK",
+	"xvuRjN0OP7n9ntx+T26/J7ffk9vvz+X2c/eAYRUrb1rhOcrTTbvlDg+5ts6Xbm1v874adqCliKL4YapD",
+	"y9ZN8TabpMLGhioPRD8vRQIkYjSDftqcNnRp/6va6OgBzGCA4ROl5QUR959rqLXEr8LxL2w4vtLvCJ1d",
+	"uCs8ta5Vs2t6eW/gShPrS9T/ZryaYwp5Oyo/fHvwDAIHrsGgB/Xc7oHz/MN5WJ5M+l9svOGezPDBUT8Z",
+	"4nczfXu/5JPx+4GM3x1P8Bdr/vZwfskG8O8AF3J+PnATq6+xouZgCaH9UN0OnxAkDkcMqFewGOnYd1Rh",
+	"wgdr7zVfoZ/soTxdM6N5tKcbwIK3Ud7TO3r9E37opKdOMYD+0fjfDLkEYiKdGXeIzZ5Tmnzv4Ey1TM0C",
+	"Lpr96XENrRRSyEAIzBfNSu2SjKZQgClparI7VbUSGtYk3VvuEw4rri7VzvtVLxtcKF3F54FNReE4V5+i",
+	"V9ZRdDbEbrjAkojpYu3at7HSftvfAk2ZxEZbWY06PYRRyS9rLrl+YYJfNi5ZKh6B7N7gCmdELuKJqWWd",
+	"zbXQa32WVsi9AhQL1dTFM4bDEno+OFxwwCrtq/HF0ag7UcG7Swqh0e2WZvY3NTTc6AZViEAV5kqBbVVK",
+	"2SGnvzFkL4OpGRVm2u2rrsCUA1yIgsmlMFScKYao+Kwei7Kac6CqXMS0Uzrm33cAxurmSwCxFluBbjDR",
+	"bN8U4FRAdIHc1ykNbM73+JaUdYloHzaPMhYiMCeHuULvdir54b1ndTvKfgSm0vhDujq18adr/7S3sjtj",
+	"brvARrusIh8wqQ0xHedQ0mHSArhKVbZFSkKTs89qzuYwtvpOQYRaVl3zajCj0WYEjJMZoU0lob3mPAdM",
+	"Gec5McNOWx++0tHk5rjrmuPcL0F0gI9rxTIoECNJqc31VeV9goSjJio92V4QL3Gs6ETPB3XKSalkMX1W",
+	"Lg02NNU9kvdpw4B9nThNRAD5Y5et3+q1bJ/V7jlUBc6GhNOo/SRS1yiNhbnYqfXzcu2aUiyHswam+65T",
+	"/8ajwkMz5/AjI0pwU9d2g43v7DuJuT7MbxtV1l0KwWPSP1+2h0s+SDJ2pWtR7wr85N4Ty4IvfFgcPQXg",
+	"Z1DVcjCAxf1m4iuNTV5fET2sy4nwJSPFsJBgbZeqJkUjJJT4V8bVNelb+jSxgKyeFIGZZuNqQFNjvBVD",
+	"dQ61zG/z2BSS+MKRmPqYhP1BQ+g1Lkh+4d35S7bKQWHfQf6dvUJkC15dxGryfs+ulaCPr4Er35+lMUlK",
+	"HdqoKUw9qAC44hy2KIkr+pSqy7gkRUEEZIzmIoSbUNMpamuNgbILPtRN6MzJVaKBz/dxmNQSUbgGbkry",
+	"qWo8HuS97uyaAb1tono0PisyxmEpC9AjUjQBeQNA0aG+lo7GuluOxVaqkCBjPFcqgCW8JiZUT6A++6hd",
+	"BvXb431uu60nthnFN0XIisVekcAWI1sDGDUyR0pRYzRAjL1CszJ42+KkrrHNIVMEYt75YuKzT0kFBaGg",
+	"S9/FTWnqC1grSbzicE1YLZCQUBkjkxBO0yLm8RYNgGKNQsbofVDNNuzdoeYL1lZWueZ3BWIGuYt+0tbW",
+	"VBXcFZ0ODh8+nv7jx+EKkoNZ88R054iBjGIAB9Ecpm6h+vs6CYoptaMx3KDtWnno0/weV1UXix5ACLII",
+	"NRhfoZAjGg8DlVc+KztJ2lguCEW6mNa6WpGDQ837VNSp72Izx/DL7pxjSXTFGmHC7qT3GCW8KsDWLfko",
+	"cbUD2N+JIvdYr4enGyN/JLbt4b3uLQqMIMefqDqiu1zXYVjmJv4Cy/65H6LNESShNbTJq6lxb0UCTXBi",
+	"n+bmPVQifOg6gfdcW69fj3pQpgu6wkbbwyDTYNBFz+swc99vQPdjgNx1ZEhRTfXOhhUyfXYOEQioEo3y",
+	"SGs59bwP5D9Ey2QGuYckzPtJ7gl3/DF7jjyweljH0gi4iQ7n98ErWrzH44qTDB6rLmVUkn0V+aCTTxSh",
+	"EbrUh3qJRkHXCVeIS529llggt4NNgSk12o1p12vtFppSai742lrBfN3qXQptyIwy7pf6VTAaLqQVPeft",
+	"0ov95/kPH420355ZfSrPNYayKTKql/bFKmhUeVd9vQbLXOhjHP4qs4Y24+k19fBwzTF6rx8p3qdLtDdJ",
+	"Cpd2bo+BKaIGx/UPwqZtYJQzORKghqkflcRuITStUy419JclYL0rrrFKeFQZKyeEavHZu8HafVc+0U/0",
+	"nWpSbORQNvXvh71fmkQSpdMH6iCj0C5ebkvt6tnGgdbj0u8MuiRponbZ/ufC0YwBTf8D07ZC1Bp3zwbk",
+	"Lgt9WA0qbAwwyLxDR7C2dwvU8TwNOB/G6JXOlRSRjj1KyPXhJD1WrUx7bBpp8/wWCrxAE5gyS4q6Q4Cu",
+	"tL3oWS3H6Mc5oFy/YgwwlrR0p2xRT4SCk/rX60oB+gKVhNpAI48TLw4PlT9WxzQkJy8PD/XfhJq/d4mf",
+	"KPHtBZYSymq9MApJShCdc7khRYEKZdQ1XXa9V8IcVPghz4LPONrPN6yN6w2u7QPLFfq2OlRs2EjLiGd2",
+	"ir5TBCb17EJpZEFY8eY3f86JytS74IzJC/N9v+/QP8j2+rgXmXtaQxFAtzkmF2w2M3bz7dXpMlq24Xv9",
+	"HBWkJDLeo2lroHlNL1wzlC+u58Trv5+3Ufxhb4dzJXbXRdS3oCLc1D3d7EiJKZ41kofnTv1oJA6r65AI",
+	"u7aOgbRvrGfJVqFBPKaMvOGMhh3Jza8T9RU3c+iW7ec1bTHO5C8HRy/QX8z/YovOiYgXHbGlPUXMRK9W",
+	"SRErcp18re6ydfVEdzhnNY21fF6e8uF2dy9tbitci1jMwql+7tfSeYr/4hootNoniL5y1WSvLPG/+CxG",
+	"Ux5JqO1EjPol44VSH8gSFGnVvLWRJbLnoUa5t1bhPb40bJY+d+bkLl/kM5BiY3fCpBDj8+bYtmZzk0Js",
+	"w+KGUzS3xZh98aAxemtnYFyYUtSXf52zmheLS6VYXf5VecQX6OiwvNR6mKgrq0lJxtbnYJsICl+dVfQP",
+	"2NbkqenI/pKAuakJt2f7aHhDL633jZTFo2hu5bwl2m7u6Fq/Ho5O9rWRFMvlsYYp+RfWFsdWecmauffn",
+	"Jtu+rkzY2mtVPy8iOo28thaitFS0/iHY4WsewQZ3r0LWh7p+73SSjwROcfGWZRFe+J5QE1ijD96c+fkN",
+	"nhldq+aFbdV5cnAgzOMxYYl2g01Zf7of1YERgV5/c95kZaNz4CqmbIJFk1z1QwX01ekH9Gx86DmUNiMo",
+	"O6MkUp+1mkbPcAZCIjV8FL6oDPPAhVn6cPx8/G2iXVtAcUWSk+TZ+HD8TIdlyLn+dtVt9OD66MA3VFYP",
+	"ZxCLQBZXYTw3s9bTyE2hTUULQz0+hUz5V9R4Z7gtrNHYZAtpNVt5QUpWU+lsTuNP9MegQpFOABFh8VT1",
+	"vls0RVirzt6QHSk0WAbwJHpfjKHmQ65j7YT0n5C0L8x/PvYNRNSin2vg6va0rhhzOaaJuXkjqejKIeM2",
+	"Tx+s4sdGwJQ2QlpXljNodqBN1ie/B/NtWP/A1cDsy/i9XJcP/QNUr70wAHbHGno16ULcct87Hb1XqjQU",
+	"e3gBVnQQMkkTiWci9EiKROeB9vD/wBfCq9iwIgix+rGZSYXRlt6m7F3q/R32yvV5PONP9LUfZugiJ8Lm",
+	"nOY6HL2fQaFOMw9KAfrJkC080XF4mrlz183emM5dXT4Pge8WuFYbSXQz1zJrU95PNGnffcKyW6ZXSvwd",
+	"+Zrli42QcWXJQKdQ3bWFraaA0vaEsGE0Sh/fXwd1EiFXqH58ePx4IIT3+V2aPI+TnYkkN0fM3eamyfPj",
+	"byP3HGPKHLdwA0VqXBwIT6XF48ahYJ0l7fqpaog22I9e6VeUNU/zPvMPfW7B78tyUt38Zu0b3Yw/ROEW",
+	"lucxFuqsq3d3d7uwpUFuYa49s7WaYJVPchM25bTFjRnVEHkGv98Hhfb650YwdAnIXxg5n/sI8IagVhJS",
+	"YGQJiek48kbTb9Z0Zw2S8n37/ki31ifq3At1bkGHB0JywOXm5Jgi82bbseHvYyysrjA6V+f8TolYqpim",
+	"+YcWlBcVqDAVpY1fpujSF9FTf9jE1gvbdUvTg42rMHqmsd9BSaRsavb24QBhZXEDLAJ1frZn8KUjxUsd",
+	"EydT5WSXmFAnOOiIkgovVEv8bp0YUFNpFc0mBInxKgZ1bnb6D8GmJNzKA71rowaBhuX5Ph8yx8GmkTMz",
+	"pyGe2NIfRmhQdOsIsHfcmzAtqygNcyurnvVAGCP3i0fsJjHT6Cn7UO9XNiBJu8P6fWxIPAe9XQmvz2xc",
+	"Fb/7lYYGGnANK8qPKxMNtX4ahtfGvxiL80oWFPCJralmyUZtShcHWHX525w6fJRZWAqnYzPT+nuMjNp0",
+	"Y1mbCkpT9iGae2JRlyTkoTZuGVNtc9Rmtrw0iZzESnzX/Q2/ZqQ/vi9w250fV2G+Ox6XSPyoJIA0Ps85",
+	"o6wWxWIbivid5HeDpmFX0JyFDqYOCC1stKaHDlwrK563MfdvIDtHtNJou6r5lrayaqeDN7Jqx1wb6e7T",
+	"4nrvXPj5itZYbsO1sVAxng6Oxc66jWDLTn4T1PO5cyt1qq77weWTpTp71qkhTVS3icWVUDkrKoVbqWO9",
+	"sXDhEQ0K6oHaQKNLLikTp+HFDkAkJKsEsnqNiQ628oh61yePNJMJ5NxTg9rOaZM5eB+8uJvH+sA20152",
+	"ZQSH3ZiW1XQpD/UH8qR37KZ3+I1k011cKW53D5rmBXFCXq/31ACpNG3271dsaffPemCCGWgxNWidCCIJ",
+	"ms6FDyGDrH2Um2NR02tiORYt76syRj9EO1q4GJO01RUHEZoVdQ5dw5j5vPEwSvr+EfeJku1GM4+Ekp1e",
+	"LEtR0h7NVigZlV16xxsKLntAY7GqkczmaOxE6RwUukWC8XQvE1PLaeSNeRE8NlqlijlQIo6NIvGKB1fS",
+	"zJVRCZWA0kbgYdtLUOnzxsU0xOaneXe4NTJDjmw7Fh+90qYR84Hvwniz9aT22P5/HSK7+eT1aOSsufdt",
+	"T5t7JBLEOKJMJ52YvhAO4cxk364zGVFVm2heGJE5tP82CRm70GJDEDsRoO/lMHyD+J4qcdurNc+0O1gM",
+	"WkLHn2hgd9TmHV+iuSmS3y6+rZLq1Nk0sxNDaur1phissPqFX4LRYmFtDS3IDMsxgPnDsPFZktcicoP5",
+	"PXggE2ivZVGEOIbP5VHMoP3WO8tgfnhT6NLtWptefs+W2Xx2DAc0tnl16wzF6qEZURU2+q2Bx8ssQasu",
+	"k3iuQOQSyTa8RdI/Z4TghoGBfTKJmonE4FX2kZnwV88L5/i6x0Y9quwsAraAitk1VxPUXHfnGaSjN3PI",
+	"rsw32ZFd7P7OPb63g2o1EIqckWu2YABcdK/nyBe4PbEPWhuia3Qf8FYl2ejW6JhO3qoqG5S/FGkrfJpw",
+	"ZeyTrVbEZ6665AQUmjCOCqbu6RvQ4f0mwNI4IoOylDqTlMzmIKQtQqkuX1t8Xlv34rHDQW3chwi77ZTj",
+	"XSPm1jYPC3Y+EkN70xvUnKY+ufZh+kzL5aeo7qIg8tC9lFr12recNfmslkMT3stbjW78uYfhIfbdrbbO",
+	"jr/rf/LuUc6RfQzOqHn2y106IOaewYyYLr1qb3tZ1RE2Z8paBFU5atq1iOBMEZs+SWNUz9p5h+NPdFVW",
+	"sia0K6iks7C6j3GIEdEldZp2kMV5H6JqN2NzLUPL0d6XjwYAuR1y+eqr5Eu/pfsQNM3uK82sj5QDOBnj",
+	"HStNIm/18/g6Ye5U07ioiYIiJvAdT6fas97DIDN3gEFrGiOC77wPG0RE9vFnbbYpHxSS/MD92MKW7f6m",
+	"p3ygM/eHNXCdwD900B9Zt36bcyTXVNq8Bo/fur6TqMvIketFvrgTP3xYhmFLKDwQDi05141RyBzrMA6d",
+	"6d+VG0t/YhSVztR1UxJdW7nJZmlVALFvazS7IlUVQSSz0p8dkxyZPRAquePdBJfu/POeOVNVMpBznaGl",
+	"cz37/s4N8kVbGaLi5OBAFQATYwpSydUHOcvEgf0jufMHHqbArVH2Riyre2OnDOXNgSbCxhTYahscmcjI",
+	"/P1JfgJOprYBlNHyjJ3yGpMCT0hhGpzZScwA1Xrx/wYAa0x4hu3MAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// signResponse has the node sign the execution response. Response is returned unsigned if signing fails.
+func (a *API) signResponse(res *ExecutionResponse) {
+
+	signed, err := signedResponse(*res)
+	if err != nil {
+		a.Log.Warn().Err(err).Str("request", res.RequestId).Msg("could not prepare response for signing")
+		return
+	}
+
+	err = a.Node.SignResponse(&signed)
+	if err != nil {
+		a.Log.Warn().Err(err).Str("request", res.RequestId).Msg("could not sign response")
+		return
+	}
+
+	// Node may choose not to sign the response.
+	if signed.Signature == "" {
+		return
+	}
+
+	res.Head = signed.Head.String()
+	res.Signature = signed.Signature
+}
+
+// signedResponse returns the signed view of the execution response, built from its JSON form - the same way a client would verify it.
+func signedResponse(res ExecutionResponse) (execute.SignedResponse, error) {
+
+	res.Head = ""
+	res.Signature = ""
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return execute.SignedResponse{}, fmt.Errorf("could not encode response: %w", err)
+	}
+
+	var signed execute.SignedResponse
+	err = json.Unmarshal(payload, &signed)
+	if err != nil {
+		return execute.SignedResponse{}, fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return signed, nil
+}
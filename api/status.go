@@ -0,0 +1,55 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (r FunctionStatusRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("request ID is required")
+	}
+
+	return nil
+}
+
+// ExecutionStatus implements the REST API endpoint for retrieving the status of an execution request.
+func (a *API) ExecutionStatus(ctx echo.Context) error {
+
+	// Get the request ID.
+	var request FunctionStatusRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = request.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing request ID"))
+	}
+
+	// Lookup execution status.
+	status, record, ok := a.Node.ExecutionStatus(ctx.Request().Context(), request.Id)
+	if !ok {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	res := FunctionStatusResponse{
+		RequestId: request.Id,
+		Status:    status,
+	}
+
+	// Include the execution result if the execution is complete.
+	if status.Final() {
+		result := executionResponseFromRecord(record)
+		a.signResponse(&result)
+		res.Result = &result
+	}
+
+	// Send the response back.
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,148 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_ExecutionStatus(t *testing.T) {
+	t.Run("execution done", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.FunctionStatusRequest{
+			Id: mocks.GenericUUID.String(),
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionStatusResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, req.Id, res.RequestId)
+		require.Equal(t, execute.StatusDone, res.Status)
+
+		require.NotNil(t, res.Result)
+		require.Equal(t, mocks.GenericExecutionRecord.Code.String(), res.Result.Code)
+		require.Len(t, res.Result.Results, 1)
+		require.Equal(t, mocks.GenericExecutionResult.Result, res.Result.Results[0].Result)
+	})
+	t.Run("execution in progress", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (execute.Status, bls.ExecutionRecord, bool) {
+			return execute.StatusFormingCluster, bls.ExecutionRecord{}, true
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionStatusRequest{
+			Id: mocks.GenericUUID.String(),
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionStatusResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, execute.StatusFormingCluster, res.Status)
+		require.Nil(t, res.Result)
+	})
+	t.Run("request not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (execute.Status, bls.ExecutionRecord, bool) {
+			return "", bls.ExecutionRecord{}, false
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionStatusRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing request ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.FunctionStatusRequest{}
+
+		_, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_ExecutionStatus_SignedResult(t *testing.T) {
+
+	priv, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+
+	head, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+
+	node := mocks.BaselineNode(t)
+	node.SignResponseFunc = func(res *execute.SignedResponse) error {
+		return res.Sign(priv)
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	rec, ctx, err := setupRecorder(statusEndpoint, api.FunctionStatusRequest{Id: mocks.GenericUUID.String()})
+	require.NoError(t, err)
+
+	err = srv.ExecutionStatus(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var res struct {
+		Result json.RawMessage `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.NotEmpty(t, res.Result)
+
+	err = execute.VerifyResponse(res.Result, head)
+	require.NoError(t, err)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+const (
+	// Name of the final event in the stream, containing the execution response.
+	streamResponseEvent = "response"
+
+	streamEventBufferSize = 100
+)
+
+// ExecuteFunctionStream implements the REST API endpoint for function execution, streaming execution progress as Server-Sent Events.
+func (a *API) ExecuteFunctionStream(ctx echo.Context) error {
+
+	err := a.admit(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Unpack the API request.
+	var req ExecutionRequest
+	err = ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	exr := execute.Request{
+		Config:     req.Config,
+		FunctionID: req.FunctionId,
+		Method:     req.Method,
+		Parameters: req.Parameters,
+	}
+
+	err = exr.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	var (
+		events   = make(chan execute.Event, streamEventBufferSize)
+		done     = make(chan ExecutionResponse, 1)
+		rejected = make(chan error, 1)
+	)
+
+	progress := func(event execute.Event) {
+		select {
+		case events <- event:
+		case <-reqCtx.Done():
+		}
+	}
+
+	go func() {
+		var (
+			code    codes.Code
+			id      string
+			results execute.ResultMap
+			cluster execute.Cluster
+			err     error
+		)
+		if req.IdempotencyKey != "" {
+			code, id, results, cluster, err = a.Node.ExecuteFunctionIdempotent(reqCtx, req.IdempotencyKey, exr, req.Topic, progress)
+		} else {
+			code, id, results, cluster, err = a.Node.ExecuteFunctionWithProgress(reqCtx, exr, req.Topic, progress)
+		}
+		if errors.Is(err, bls.ErrTooManyRequests) || errors.Is(err, bls.ErrIdempotencyKeyMismatch) {
+			rejected <- err
+			return
+		}
+		if err != nil {
+			a.Log.Warn().Str("function", req.FunctionId).Err(err).Msg("node failed to execute function")
+		}
+
+		res := executionResponse(code, id, results, exr.Config.ResultAggregation, cluster, err)
+		a.signResponse(&res)
+
+		done <- res
+	}()
+
+	// The stream is started once the first event is written, so rejected requests can still get a proper response.
+	w := ctx.Response()
+
+	for {
+		select {
+		case err := <-rejected:
+			if errors.Is(err, bls.ErrIdempotencyKeyMismatch) {
+				return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
+			}
+
+			return a.tooManyRequests(ctx, "queue_full", queueFullRetryAfter, err)
+
+		case event := <-events:
+			err = writeEvent(w, string(event.Type), event)
+			if err != nil {
+				return err
+			}
+
+		case res := <-done:
+
+			// Execution is done so no more events are coming - send out the ones we have left.
+			for len(events) > 0 {
+				event := <-events
+				err = writeEvent(w, string(event.Type), event)
+				if err != nil {
+					return err
+				}
+			}
+
+			return writeEvent(w, streamResponseEvent, res)
+
+		case <-reqCtx.Done():
+			return nil
+		}
+	}
+}
+
+// writeEvent writes a single Server-Sent Event, starting the stream if needed.
+func writeEvent(w *echo.Response, name string, data any) error {
+
+	if !w.Committed {
+		w.Header().Set(echo.HeaderContentType, "text/event-stream")
+		w.Header().Set(echo.HeaderCacheControl, "no-cache")
+		w.Header().Set(echo.HeaderConnection, "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		w.Flush()
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("could not encode event: %w", err)
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, payload)
+	if err != nil {
+		return fmt.Errorf("could not write event: %w", err)
+	}
+
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_ExecuteStream(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(streamEndpoint, mocks.GenericExecutionRequest)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionStream(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, "text/event-stream", rec.Result().Header.Get(echo.HeaderContentType))
+
+		type sse struct {
+			name string
+			data string
+		}
+
+		// Parse the event stream.
+		var events []sse
+		for _, chunk := range strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n") {
+
+			lines := strings.Split(chunk, "\n")
+			require.Len(t, lines, 2)
+
+			events = append(events, sse{
+				name: strings.TrimPrefix(lines[0], "event: "),
+				data: strings.TrimPrefix(lines[1], "data: "),
+			})
+		}
+
+		require.Len(t, events, 3)
+
+		// Verify progress events.
+		require.Equal(t, string(execute.EventRollCall), events[0].name)
+		require.Equal(t, string(execute.EventResult), events[1].name)
+
+		var event execute.Event
+		require.NoError(t, json.Unmarshal([]byte(events[1].data), &event))
+		require.Equal(t, execute.EventResult, event.Type)
+		require.Equal(t, mocks.GenericUUID.String(), event.RequestID)
+		require.Equal(t, mocks.GenericPeerID, event.Peer)
+
+		// Verify stream ends with the execution response.
+		require.Equal(t, "response", events[2].name)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal([]byte(events[2].data), &res))
+
+		require.Equal(t, mocks.GenericExecutionResult.Code.String(), res.Code)
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+		require.Len(t, res.Results, 1)
+		require.Equal(t, mocks.GenericExecutionResult.Result, res.Results[0].Result)
+	})
+	t.Run("invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ExecutionRequest{
+			Method: mocks.GenericExecutionRequest.Method,
+		}
+
+		_, ctx, err := setupRecorder(streamEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionStream(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
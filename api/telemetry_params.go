@@ -0,0 +1,16 @@
+package api
+
+import (
+	"github.com/armon/go-metrics/prometheus"
+)
+
+var (
+	requestsRejectedMetric = []string{"api", "requests", "rejected"}
+)
+
+var Counters = []prometheus.CounterDefinition{
+	{
+		Name: requestsRejectedMetric,
+		Help: "Number of API requests rejected by admission control.",
+	},
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+func (r FunctionUninstallRequest) Valid() error {
+
+	if r.Cid == "" {
+		return errors.New("function CID is required")
+	}
+
+	return nil
+}
+
+// UninstallFunction implements the REST API endpoint for uninstalling a function.
+func (a *API) UninstallFunction(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req FunctionUninstallRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	uninstall, err := a.Node.UninstallFunction(ctx.Request().Context(), req.Cid, req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("function uninstallation failed: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, functionUninstallResponse(uninstall))
+}
+
+func functionUninstallResponse(uninstall bls.FunctionUninstall) FunctionUninstallResponse {
+
+	res := FunctionUninstallResponse{
+		Code:      strconv.Itoa(http.StatusOK),
+		RequestId: uninstall.RequestID,
+		Cid:       uninstall.CID,
+		Succeeded: make([]string, 0, len(uninstall.Succeeded)),
+		Failed:    make([]FunctionInstallFailure, 0, len(uninstall.Failed)),
+		NoAnswer:  make([]string, 0, len(uninstall.NoAnswer)),
+	}
+
+	for _, peer := range uninstall.Succeeded {
+		res.Succeeded = append(res.Succeeded, peer.String())
+	}
+
+	for _, failure := range uninstall.Failed {
+		res.Failed = append(res.Failed, FunctionInstallFailure{
+			Peer:  failure.Peer.String(),
+			Error: failure.Error,
+		})
+	}
+
+	for _, peer := range uninstall.NoAnswer {
+		res.NoAnswer = append(res.NoAnswer, peer.String())
+	}
+
+	return res
+}
@@ -0,0 +1,93 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/api"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAPI_FunctionUninstall(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		req := api.FunctionUninstallRequest{
+			Cid:   "dummy-cid",
+			Topic: "dummy-topic",
+		}
+
+		node := mocks.BaselineNode(t)
+		node.UninstallFunctionFunc = func(_ context.Context, cid string, subgroup string) (bls.FunctionUninstall, error) {
+			require.Equal(t, req.Cid, cid)
+			require.Equal(t, req.Topic, subgroup)
+			return mocks.GenericFunctionUninstall, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(uninstallEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionUninstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		expected := mocks.GenericFunctionUninstall
+		require.Equal(t, expected.RequestID, res.RequestId)
+		require.Equal(t, expected.CID, res.Cid)
+		require.Equal(t, []string{expected.Succeeded[0].String()}, res.Succeeded)
+		require.Equal(t, []api.FunctionInstallFailure{{Peer: expected.Failed[0].Peer.String(), Error: expected.Failed[0].Error}}, res.Failed)
+		require.Equal(t, []string{expected.NoAnswer[0].String()}, res.NoAnswer)
+	})
+}
+
+func TestAPI_FunctionUninstall_HandlesErrors(t *testing.T) {
+	t.Run("missing CID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(uninstallEndpoint, api.FunctionUninstallRequest{})
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node fails to uninstall function", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.UninstallFunctionFunc = func(context.Context, string, string) (bls.FunctionUninstall, error) {
+			return bls.FunctionUninstall{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(uninstallEndpoint, api.FunctionUninstallRequest{Cid: "dummy-cid"})
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
@@ -27,6 +27,7 @@ func main() {
 		flagMessage   string
 		flagSignature string
 		flagPeerID    string
+		flagResponse  string
 	)
 
 	pflag.StringVar(&flagPeerID, "peerid", "", "PeerID for verification")
@@ -36,9 +37,20 @@ func main() {
 	pflag.StringVar(&flagPublicKey, "pubkey", "", "Base64 encoded public key for verification")
 	pflag.StringVar(&flagMessage, "message", "", "The original message to verify")
 	pflag.StringVar(&flagSignature, "signature", "", "Base64 encoded signature to verify")
+	pflag.StringVar(&flagResponse, "response", "", "file with a saved execution response to verify, signed by the head node with the given PeerID")
 
 	pflag.Parse()
 
+	// Verifying a response does not need local keys.
+	if flagResponse != "" {
+		if flagPeerID == "" {
+			log.Fatalf("PeerID of the head node is required to verify a response")
+		}
+
+		VerifyResponseWithPeerID(flagPeerID, flagResponse)
+		return
+	}
+
 	// Initialize output directory
 	err := os.MkdirAll(flagOutputDir, os.ModePerm)
 	if err != nil {
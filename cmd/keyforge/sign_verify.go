@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
 	"path/filepath"
 
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/execute"
 )
 
 // HandleSignAndVerify performs the signing and verification based on the provided keys and flags
@@ -120,3 +123,24 @@ func VerifyGivenSignatureWithPeerID(peerIDStr string, message string, encodedSig
 
 	VerifyGivenSignature(pubKeyBase64, message, encodedSignature)
 }
+
+// VerifyResponseWithPeerID verifies that the execution response saved in the given file was signed by the head node with the given PeerID.
+func VerifyResponseWithPeerID(peerIDStr string, responseFile string) {
+	log.Println("Verifying execution response with peerid")
+	peerID, err := peer.Decode(peerIDStr)
+	if err != nil {
+		log.Fatalf("Could not decode PeerID: %s", err)
+	}
+
+	data, err := os.ReadFile(responseFile)
+	if err != nil {
+		log.Fatalf("Could not read response file: %s", err)
+	}
+
+	err = execute.VerifyResponse(data, peerID)
+	if err != nil {
+		log.Fatalf("Response verification failed: %s", err)
+	}
+
+	fmt.Println("Response verified successfully.")
+}
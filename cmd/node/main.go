@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -85,7 +86,13 @@ func run() int {
 			return failure
 		}
 
-		server = createEchoServer(log)
+		ipExtractor, err := apiIPExtractor(cfg.Head.TrustedProxies)
+		if err != nil {
+			log.Error().Err(err).Strs("trusted_proxies", cfg.Head.TrustedProxies).Msg("could not parse trusted proxies")
+			return failure
+		}
+
+		server = createEchoServer(log, ipExtractor)
 	}
 
 	// TODO: Change how node starts up with regards to key/no-key.
@@ -216,6 +223,12 @@ func run() int {
 		cfg.Topics = append(cfg.Topics, bls.DefaultTopic)
 	}
 
+	// Head nodes sharing request state also subscribe to the topics used to exchange it.
+	topics := cfg.Topics
+	if nodeRole == bls.HeadNode && cfg.Head.ShareRequestState {
+		topics = append(slices.Clone(cfg.Topics), stateTopics(cfg.Topics)...)
+	}
+
 	// Instantiate node.
 
 	// First, initialize the node core, common for both node types.
@@ -223,7 +236,7 @@ func run() int {
 		log.With().Str("component", "node").Logger(),
 		host,
 		node.Concurrency(cfg.Concurrency),
-		node.Topics(cfg.Topics),
+		node.Topics(topics),
 	)
 
 	var (
@@ -245,7 +258,7 @@ func run() int {
 		}
 
 	case bls.HeadNode:
-		node, err = createHeadNode(core, cfg)
+		node, err = createHeadNode(core, store, cfg)
 	}
 	if err != nil {
 		log.Error().Err(err).Msg("could not create node")
@@ -284,7 +297,10 @@ func run() int {
 				log.Error().Msg("invalid node type - not a head node")
 			}
 
-			apiHandler := api.New(log.With().Str("component", "api").Logger(), headNode)
+			apiHandler := api.New(log.With().Str("component", "api").Logger(), headNode,
+				api.RateLimit(cfg.Head.RateLimit, cmp.Or(cfg.Head.RateBurst, api.DefaultRateBurst)),
+				api.APIKeys(cfg.Head.APIKeys...),
+			)
 			api.RegisterHandlers(server, apiHandler)
 		}
 
@@ -329,10 +345,11 @@ func run() int {
 	return success
 }
 
-func createEchoServer(log zerolog.Logger) *echo.Echo {
+func createEchoServer(log zerolog.Logger, ipExtractor echo.IPExtractor) *echo.Echo {
 	server := echo.New()
 	server.HideBanner = true
 	server.HidePort = true
+	server.IPExtractor = ipExtractor
 
 	elog := lecho.From(log)
 	server.Logger = elog
@@ -342,6 +359,31 @@ func createEchoServer(log zerolog.Logger) *echo.Echo {
 	return server
 }
 
+// apiIPExtractor returns the function determining the IP address of API clients. Without trusted proxies, the address
+// the request came from is used. Otherwise, the X-Forwarded-For header is used, but only when set by one of the trusted proxies.
+func apiIPExtractor(proxies []string) (echo.IPExtractor, error) {
+
+	if len(proxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range proxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP range (range: %s): %w", proxy, err)
+		}
+
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
 func needLimiter(cfg *config.Config) bool {
 	return (cfg.Worker.CPUPercentageLimit > 0 && cfg.Worker.CPUPercentageLimit < 1.0) || cfg.Worker.MemoryLimitKB > 0
 }
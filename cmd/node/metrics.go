@@ -5,12 +5,14 @@ import (
 
 	mp "github.com/armon/go-metrics/prometheus"
 
+	"github.com/Maelkum/b7s/api"
 	"github.com/Maelkum/b7s/consensus/pbft"
 	"github.com/Maelkum/b7s/consensus/raft"
 	"github.com/Maelkum/b7s/executor"
 	"github.com/Maelkum/b7s/fstore"
 	"github.com/Maelkum/b7s/host"
 	"github.com/Maelkum/b7s/node"
+	"github.com/Maelkum/b7s/node/head"
 )
 
 func metricCounters() []mp.CounterDefinition {
@@ -20,6 +22,8 @@ func metricCounters() []mp.CounterDefinition {
 		host.Counters,
 		fstore.Counters,
 		executor.Counters,
+		head.Counters,
+		api.Counters,
 	)
 
 	return counters
@@ -39,7 +43,10 @@ func metricSummaries() []mp.SummaryDefinition {
 
 func metricGauges() []mp.GaugeDefinition {
 
-	// Right now we have a single gauge - node info.
-	gauges := node.Gauges
+	gauges := slices.Concat(
+		node.Gauges,
+		head.Gauges,
+	)
+
 	return gauges
 }
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+
 	"github.com/Maelkum/b7s/config"
 	"github.com/Maelkum/b7s/executor"
 	"github.com/Maelkum/b7s/executor/limits"
@@ -52,9 +55,23 @@ func createWorkerNode(core node.Core, store bls.Store, cfg *config.Config) (Node
 		return nil, shutdown, fmt.Errorf("could not create an executor: %w", err)
 	}
 
+	gateways := cfg.Worker.AttributeGateways
+	if len(gateways) == 0 {
+		gateways = worker.DefaultAttributeGateways
+	}
+
+	heads, err := peerIDs(cfg.Worker.TrustedHeads)
+	if err != nil {
+		return nil, shutdown, fmt.Errorf("could not parse trusted head nodes: %w", err)
+	}
+
 	worker, err := worker.New(core, fstore, executor,
-		worker.AttributeLoading(cfg.LoadAttributes),
+		worker.AttributeLoading(cfg.LoadAttributes || cfg.Worker.AttributesPath != ""),
+		worker.AttributesPath(cfg.Worker.AttributesPath),
+		worker.AttributeGateways(gateways),
+		worker.AttributesRefreshInterval(cfg.Worker.AttributesRefreshInterval),
 		worker.Workspace(cfg.Workspace),
+		worker.TrustedHeads(heads...),
 	)
 	if err != nil {
 		return nil, shutdown, fmt.Errorf("could not create a worker node: %w", err)
@@ -63,12 +80,65 @@ func createWorkerNode(core node.Core, store bls.Store, cfg *config.Config) (Node
 	return worker, shutdown, nil
 }
 
-func createHeadNode(core node.Core, cfg *config.Config) (Node, error) {
+func createHeadNode(core node.Core, store bls.Store, cfg *config.Config) (Node, error) {
+
+	opts := []head.Option{
+		head.ExecutionResultTTL(cmp.Or(cfg.Head.ExecutionResultTTL, head.DefaultExecutionResultTTL)),
+		head.ExecutionResultLimit(cmp.Or(cfg.Head.ExecutionResultLimit, head.DefaultExecutionResultLimit)),
+		head.DefaultSelection(cmp.Or(cfg.Head.SelectionStrategy, head.DefaultSelectionStrategy)),
+		head.SelectionWindow(cmp.Or(cfg.Head.SelectionWindow, head.DefaultSelectionWindow)),
+		head.MaxConcurrentExecutions(cfg.Head.MaxConcurrentExecutions),
+		head.ExecutionQueueSize(cmp.Or(cfg.Head.ExecutionQueueSize, head.DefaultExecutionQueueSize)),
+		head.ReputationThreshold(cfg.Head.ReputationThreshold),
+		head.ReputationPolicy(cmp.Or(cfg.Head.ReputationPolicy, head.DefaultReputationPolicy)),
+		head.ReputationHalfLife(cmp.Or(cfg.Head.ReputationHalfLife, head.DefaultReputationHalfLife)),
+		head.IdempotencyKeyTTL(cmp.Or(cfg.Head.IdempotencyKeyTTL, head.DefaultIdempotencyKeyTTL)),
+		head.ResultCacheTTL(cmp.Or(cfg.Head.ResultCacheTTL, head.DefaultResultCacheTTL)),
+		head.ResultCacheSize(cmp.Or(cfg.Head.ResultCacheSize, head.DefaultResultCacheSize)),
+		head.InstallTimeout(cmp.Or(cfg.Head.InstallTimeout, head.DefaultInstallTimeout)),
+		head.RequireSignedResults(cfg.Head.RequireSignedResults),
+	}
+
+	if cfg.Head.ShareRequestState {
+		heads, err := peerIDs(cfg.Head.StateHeads)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse trusted head nodes: %w", err)
+		}
+
+		opts = append(opts, head.ShareState(stateTopics(cfg.Topics)...), head.StateHeads(heads...))
+	}
 
-	head, err := head.New(core)
+	head, err := head.New(core, store, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not create a head node: %w", err)
 	}
 
 	return head, nil
 }
+
+// peerIDs parses the given list of peer IDs.
+func peerIDs(ids []string) ([]peer.ID, error) {
+
+	peers := make([]peer.ID, 0, len(ids))
+	for _, id := range ids {
+		p, err := peer.Decode(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer ID (id: %s): %w", id, err)
+		}
+
+		peers = append(peers, p)
+	}
+
+	return peers, nil
+}
+
+// stateTopics returns the topics head nodes use to share execution request state.
+func stateTopics(topics []string) []string {
+
+	out := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		out = append(out, head.StateTopic(topic))
+	}
+
+	return out
+}
@@ -12,6 +12,8 @@ const (
 	DefaultConcurrency  = 10
 	DefaultUseWebsocket = false
 	DefaultLogLevel     = "info"
+
+	DefaultAttributesRefreshInterval = time.Hour
 )
 
 // Default names for storage directories.
@@ -31,6 +33,10 @@ var DefaultConfig = Config{
 		Port:      DefaultPort,
 		Websocket: DefaultUseWebsocket,
 	},
+	// Set here instead of when creating the node, since zero is a valid value that disables reloading.
+	Worker: Worker{
+		AttributesRefreshInterval: DefaultAttributesRefreshInterval,
+	},
 }
 
 // Config describes the Bless configuration options.
@@ -74,14 +80,38 @@ type Connectivity struct {
 }
 
 type Head struct {
-	RestAPI string `koanf:"rest-api" flag:"rest-api"`
+	RestAPI                 string        `koanf:"rest-api"                  flag:"rest-api"`
+	ExecutionResultTTL      time.Duration `koanf:"execution-result-ttl"      flag:"execution-result-ttl"`
+	ExecutionResultLimit    uint          `koanf:"execution-result-limit"    flag:"execution-result-limit"`
+	SelectionStrategy       string        `koanf:"selection-strategy"        flag:"selection-strategy"`
+	SelectionWindow         time.Duration `koanf:"selection-window"          flag:"selection-window"`
+	MaxConcurrentExecutions uint          `koanf:"max-concurrent-executions" flag:"max-concurrent-executions"`
+	ExecutionQueueSize      uint          `koanf:"execution-queue-size"      flag:"execution-queue-size"`
+	RateLimit               float64       `koanf:"rate-limit"                flag:"rate-limit"`
+	RateBurst               uint          `koanf:"rate-burst"                flag:"rate-burst"`
+	APIKeys                 []string      `koanf:"api-keys"                  flag:"api-keys"`
+	TrustedProxies          []string      `koanf:"trusted-proxies"           flag:"trusted-proxies"`
+	ShareRequestState       bool          `koanf:"share-request-state"       flag:"share-request-state"`
+	StateHeads              []string      `koanf:"state-heads"               flag:"state-heads"`
+	ReputationThreshold     float64       `koanf:"reputation-threshold"      flag:"reputation-threshold"`
+	ReputationPolicy        string        `koanf:"reputation-policy"         flag:"reputation-policy"`
+	ReputationHalfLife      time.Duration `koanf:"reputation-half-life"      flag:"reputation-half-life"`
+	IdempotencyKeyTTL       time.Duration `koanf:"idempotency-key-ttl"       flag:"idempotency-key-ttl"`
+	ResultCacheTTL          time.Duration `koanf:"result-cache-ttl"          flag:"result-cache-ttl"`
+	ResultCacheSize         uint          `koanf:"result-cache-size"         flag:"result-cache-size"`
+	InstallTimeout          time.Duration `koanf:"install-timeout"           flag:"install-timeout"`
+	RequireSignedResults    bool          `koanf:"require-signed-results"    flag:"require-signed-results"`
 }
 
 type Worker struct {
-	RuntimePath        string  `koanf:"runtime-path"         flag:"runtime-path"`
-	RuntimeCLI         string  `koanf:"runtime-cli"          flag:"runtime-cli"`
-	CPUPercentageLimit float64 `koanf:"cpu-percentage-limit" flag:"cpu-percentage-limit"`
-	MemoryLimitKB      int64   `koanf:"memory-limit"         flag:"memory-limit"`
+	RuntimePath               string        `koanf:"runtime-path"                flag:"runtime-path"`
+	RuntimeCLI                string        `koanf:"runtime-cli"                 flag:"runtime-cli"`
+	CPUPercentageLimit        float64       `koanf:"cpu-percentage-limit"        flag:"cpu-percentage-limit"`
+	MemoryLimitKB             int64         `koanf:"memory-limit"                flag:"memory-limit"`
+	AttributesPath            string        `koanf:"attributes-path"             flag:"attributes-path"`
+	AttributeGateways         []string      `koanf:"attribute-gateways"          flag:"attribute-gateways"`
+	AttributesRefreshInterval time.Duration `koanf:"attributes-refresh-interval" flag:"attributes-refresh-interval"`
+	TrustedHeads              []string      `koanf:"trusted-heads"               flag:"trusted-heads"`
 }
 
 type Telemetry struct {
@@ -132,7 +162,7 @@ func getFlagDescription(flag string) string {
 	case "workspace":
 		return "directory that the node can use for file storage"
 	case "load-attributes":
-		return "node should try to load its attribute data from IPFS"
+		return "node should try to load its attribute data from a local file or IPFS"
 	case "topics":
 		return "topics node should subscribe to"
 	case "db":
@@ -159,6 +189,46 @@ func getFlagDescription(flag string) string {
 		return "maximum number of connections the b7s host will aim to have"
 	case "rest-api":
 		return "address where the head node REST API will listen on"
+	case "execution-result-ttl":
+		return "how long the head node keeps results of finished executions"
+	case "execution-result-limit":
+		return "maximum number of execution results the head node keeps"
+	case "selection-strategy":
+		return "default strategy for choosing among nodes that reported for roll call (first-come, random, least-loaded or lowest-latency)"
+	case "selection-window":
+		return "how long the head node collects roll call responses before choosing nodes"
+	case "max-concurrent-executions":
+		return "maximum number of executions the head node runs at the same time (0 means no limit)"
+	case "execution-queue-size":
+		return "how many execution requests can wait for an execution slot before new requests are rejected"
+	case "rate-limit":
+		return "number of execution requests per second a single API client can make (0 means no limit)"
+	case "rate-burst":
+		return "maximum number of execution requests a single API client can make at once"
+	case "api-keys":
+		return "API keys of known API clients - clients using other keys are rate limited by their IP address"
+	case "trusted-proxies":
+		return "IP ranges (CIDR) of reverse proxies trusted to report the API client IP address in the X-Forwarded-For header"
+	case "share-request-state":
+		return "share execution request state with other head nodes on the same topics"
+	case "state-heads":
+		return "peer IDs of head nodes trusted to share execution request state"
+	case "reputation-threshold":
+		return "reputation score in the 0-1 range below which worker nodes are ignored or deprioritized on roll calls (0 means reputation is not taken into account)"
+	case "reputation-policy":
+		return "how roll call responses from worker nodes with low reputation are handled (ignore or deprioritize)"
+	case "reputation-half-life":
+		return "how long it takes for the recorded worker node behavior to lose half of its weight"
+	case "idempotency-key-ttl":
+		return "how long the idempotency keys of finished execution requests are remembered"
+	case "result-cache-ttl":
+		return "how long the results of functions marked as cacheable are cached"
+	case "result-cache-size":
+		return "maximum number of cached execution results"
+	case "install-timeout":
+		return "how long the head node collects function install and uninstall responses from worker nodes"
+	case "require-signed-results":
+		return "drop unsigned execution results - enable once all worker nodes sign their results"
 	case "runtime-path":
 		return "Bless Runtime location (used by the worker node)"
 	case "runtime-cli":
@@ -167,6 +237,14 @@ func getFlagDescription(flag string) string {
 		return "amount of CPU time allowed for Bless Functions in the 0-1 range, 1 being unlimited"
 	case "memory-limit":
 		return "memory limit (kB) for Bless Functions"
+	case "attributes-path":
+		return "local file with the node attestation, used instead of IPFS gateways"
+	case "attribute-gateways":
+		return "IPFS gateways used to retrieve the node attestation"
+	case "attributes-refresh-interval":
+		return "how often the node reloads its attestation (0 disables reloading)"
+	case "trusted-heads":
+		return "peer IDs of head nodes allowed to uninstall functions from the worker node"
 	case "no-dialback-peers":
 		return "start without dialing back peers from previous runs"
 	case "must-reach-boot-nodes":
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/knadh/koanf/providers/structs"
 	"github.com/spf13/pflag"
@@ -51,6 +52,9 @@ func addFlag(fs *pflag.FlagSet, fc CLIFlag) error {
 	case bool:
 		fs.BoolP(fc.Flag, fc.Shorthand, def, fc.Description)
 
+	case time.Duration:
+		fs.DurationP(fc.Flag, fc.Shorthand, def, fc.Description)
+
 	case []string:
 		fs.StringSliceP(fc.Flag, fc.Shorthand, nil, fc.Description)
 
@@ -56,6 +56,18 @@ func TestConfig_ParseCLIArgs(t *testing.T) {
 	require.Equal(t, cpuPercentageLimit, cfg.Worker.CPUPercentageLimit)
 }
 
+func TestConfig_AttributesRefreshInterval(t *testing.T) {
+
+	cfg, err := load(nil)
+	require.NoError(t, err)
+	require.Equal(t, DefaultAttributesRefreshInterval, cfg.Worker.AttributesRefreshInterval)
+
+	// Zero disables reloading, so it should not be replaced with the default.
+	cfg, err = load([]string{"--attributes-refresh-interval", "0"})
+	require.NoError(t, err)
+	require.Zero(t, cfg.Worker.AttributesRefreshInterval)
+}
+
 func TestConfig_LoadConfigFile(t *testing.T) {
 
 	var (
@@ -16,6 +16,9 @@ type Option func(*Config)
 // PostProcessFunc is invoked by the replica after execution is done.
 type PostProcessFunc func(requestID string, origin peer.ID, request execute.Request, result execute.NodeResult)
 
+// CacheableFunc reports whether results of the function can be reused for identical requests.
+type CacheableFunc func(functionID string) bool
+
 var DefaultConfig = Config{
 	NetworkTimeout:   NetworkTimeout,
 	RequestTimeout:   RequestTimeout,
@@ -28,6 +31,7 @@ type Config struct {
 	RequestTimeout   time.Duration
 	MetadataProvider metadata.Provider
 	TraceInfo        tracing.TraceInfo
+	Cacheable        CacheableFunc // Used to mark results of successful executions as cacheable.
 }
 
 // WithNetworkTimeout sets how much time we allow for message sending.
@@ -53,6 +57,13 @@ func WithPostProcessors(callbacks ...PostProcessFunc) Option {
 	}
 }
 
+// WithCacheable sets the function used to determine if execution results are cacheable.
+func WithCacheable(fn CacheableFunc) Option {
+	return func(cfg *Config) {
+		cfg.Cacheable = fn
+	}
+}
+
 // WithMetadataProvider sets the metadata provider for the node.
 func WithMetadataProvider(p metadata.Provider) Option {
 	return func(cfg *Config) {
@@ -8,6 +8,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/Maelkum/b7s/metadata"
 	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/execute"
@@ -90,7 +91,7 @@ func (r *Replica) execute(ctx context.Context, view uint, sequence uint, digest
 
 	r.lastExecuted = sequence
 
-	metadata, err := r.cfg.MetadataProvider.Metadata(request.Execute, res.Result)
+	metadata, err := metadata.Get(r.cfg.MetadataProvider, request.Execute, res.Result)
 	if err != nil {
 		log.Warn().Err(err).Msg("could not get metadata")
 	}
@@ -105,7 +106,11 @@ func (r *Replica) execute(ctx context.Context, view uint, sequence uint, digest
 		},
 	}
 
-	err = nres.Sign(r.host.PrivateKey())
+	if res.Code == codes.OK && r.cfg.Cacheable != nil {
+		nres.Cacheable = r.cfg.Cacheable(request.Execute.FunctionID)
+	}
+
+	err = nres.Sign(r.host.PrivateKey(), request.ID)
 	if err != nil {
 		return fmt.Errorf("could not sign execution result: %w", err)
 	}
@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -54,7 +55,7 @@ func TestExecute_CreateCMD(t *testing.T) {
 	paths := executor.generateRequestPaths(requestID, functionID, functionMethod)
 
 	// Create command.
-	cmd := executor.createCmd(paths, request)
+	cmd := executor.createCmd(context.Background(), paths, request)
 	require.NotNil(t, cmd)
 
 	// Verify command to be executed is correct.
@@ -33,3 +33,26 @@ func TestFunction_RetrieveHandlesErrors(t *testing.T) {
 	_, err = fh.Get(context.Background(), testCID)
 	require.Error(t, err)
 }
+
+func TestFunction_List(t *testing.T) {
+
+	workdir := t.TempDir()
+
+	store := mocks.BaselineStore(t)
+	store.RetrieveFunctionsFunc = func(context.Context) ([]bls.FunctionRecord, error) {
+		return []bls.FunctionRecord{mocks.GenericFunctionRecord}, nil
+	}
+
+	fh := fstore.New(mocks.NoopLogger, store, workdir)
+
+	functions, err := fh.List(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []bls.FunctionRecord{mocks.GenericFunctionRecord}, functions)
+
+	store.RetrieveFunctionsFunc = func(context.Context) ([]bls.FunctionRecord, error) {
+		return nil, mocks.GenericError
+	}
+
+	_, err = fh.List(context.Background())
+	require.Error(t, err)
+}
@@ -18,12 +18,14 @@ const (
 	spanInstall     = "FunctionInstall"
 	spanIsInstalled = "IsFunctionInstalled"
 	spanSync        = "FunctionSync"
+	spanRemove      = "FunctionRemove"
 )
 
 var (
 	functionsInstalledMetric      = []string{"fstore", "functions", "installed"}
 	functionsInstalledOkMetric    = []string{"fstore", "functions", "installed", "ok"}
 	functionsInstalledErrMetric   = []string{"fstore", "functions", "installed", "err"}
+	functionsRemovedMetric        = []string{"fstore", "functions", "removed"}
 	functionsInstallTimeMetric    = []string{"fstore", "functions", "installation", "milliseconds"}
 	functionsDownloadedSizeMetric = []string{"fstore", "functions", "installed", "size", "bytes"}
 )
@@ -41,6 +43,10 @@ var Counters = []prometheus.CounterDefinition{
 		Name: functionsInstalledErrMetric,
 		Help: "Number of unsuccessful functions installs on this node in this session.",
 	},
+	{
+		Name: functionsRemovedMetric,
+		Help: "Number of functions removed from this node in this session.",
+	},
 	{
 		Name: functionsDownloadedSizeMetric,
 		Help: "Total size of (compressed) functions installed by the node in this session.",
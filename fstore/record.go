@@ -19,6 +19,17 @@ func (f *FStore) Get(ctx context.Context, cid string) (bls.FunctionRecord, error
 	return fn, nil
 }
 
+// List retrieves the records of all installed functions.
+func (f *FStore) List(ctx context.Context) ([]bls.FunctionRecord, error) {
+
+	functions, err := f.store.RetrieveFunctions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve function records: %w", err)
+	}
+
+	return functions, nil
+}
+
 func (f *FStore) getFunction(ctx context.Context, cid string) (bls.FunctionRecord, error) {
 
 	function, err := f.store.RetrieveFunction(ctx, cid)
@@ -0,0 +1,75 @@
+package fstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Maelkum/b7s/telemetry/b7ssemconv"
+)
+
+// Remove deletes the function archive, the unpacked function files and the function record.
+func (f *FStore) Remove(ctx context.Context, cid string) error {
+
+	ctx, span := f.tracer.Start(ctx, spanRemove, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(b7ssemconv.FunctionCID.String(cid)))
+	defer span.End()
+
+	// Read the function directly from storage - no need to update the timestamp of a function we're removing.
+	fn, err := f.store.RetrieveFunction(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("could not retrieve function record: %w", err)
+	}
+
+	f.log.Debug().
+		Str("cid", cid).
+		Str("archive", fn.Archive).
+		Str("files", fn.Files).
+		Msg("removing function")
+
+	for _, path := range []string{fn.Archive, fn.Files} {
+		err = f.removePath(path)
+		if err != nil {
+			return fmt.Errorf("could not remove function files (cid: %s): %w", cid, err)
+		}
+	}
+
+	err = f.store.RemoveFunction(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("could not remove function record: %w", err)
+	}
+
+	f.metrics.IncrCounter(functionsRemovedMetric, 1)
+
+	f.log.Debug().Str("cid", cid).Msg("removed function")
+
+	return nil
+}
+
+// removePath removes the given path, relative to the workdir. Paths outside of the workdir, as well as the workdir itself, are never removed.
+func (f *FStore) removePath(path string) error {
+
+	if path == "" {
+		return nil
+	}
+
+	full := filepath.Join(f.workdir, path)
+
+	rel, err := filepath.Rel(f.workdir, full)
+	if err != nil {
+		return fmt.Errorf("could not determine relative path: %w", err)
+	}
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("path not in workdir (path: %s)", path)
+	}
+
+	err = os.RemoveAll(full)
+	if err != nil {
+		return fmt.Errorf("could not remove path (path: %s): %w", full, err)
+	}
+
+	return nil
+}
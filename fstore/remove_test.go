@@ -0,0 +1,76 @@
+package fstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/fstore"
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestFunction_Remove(t *testing.T) {
+
+	const (
+		testCID = "dummy-cid"
+	)
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		workdir := t.TempDir()
+
+		// Create the function archive and files.
+		archive := filepath.Join(testCID, "archive.tar.gz")
+		files := filepath.Join(testCID, "files")
+
+		require.NoError(t, os.MkdirAll(filepath.Join(workdir, files), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(workdir, archive), []byte("archive"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(workdir, files, "function"), []byte("function"), 0o644))
+
+		store := newInMemoryStore(t)
+		require.NoError(t, store.SaveFunction(context.Background(), bls.FunctionRecord{CID: testCID, Archive: archive, Files: files}))
+
+		fh := fstore.New(mocks.NoopLogger, store, workdir)
+
+		err := fh.Remove(context.Background(), testCID)
+		require.NoError(t, err)
+
+		require.NoFileExists(t, filepath.Join(workdir, archive))
+		require.NoDirExists(t, filepath.Join(workdir, files))
+
+		_, err = store.RetrieveFunction(context.Background(), testCID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+	t.Run("function not installed", func(t *testing.T) {
+		t.Parallel()
+
+		fh := fstore.New(mocks.NoopLogger, newInMemoryStore(t), t.TempDir())
+
+		err := fh.Remove(context.Background(), testCID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+	t.Run("paths outside of workdir are not removed", func(t *testing.T) {
+		t.Parallel()
+
+		workdir := t.TempDir()
+
+		store := newInMemoryStore(t)
+		require.NoError(t, store.SaveFunction(context.Background(), bls.FunctionRecord{CID: testCID, Files: ".."}))
+
+		fh := fstore.New(mocks.NoopLogger, store, workdir)
+
+		err := fh.Remove(context.Background(), testCID)
+		require.Error(t, err)
+
+		require.DirExists(t, workdir)
+
+		// Record is kept.
+		_, err = store.RetrieveFunction(context.Background(), testCID)
+		require.NoError(t, err)
+	})
+}
@@ -54,6 +54,7 @@ This is the end of my program
 	// Phase 0: Create libp2p hosts, loggers, temporary directories and nodes.
 
 	headNode := instantiateNode(t, dirPattern, bls.HeadNode)
+	defer headNode.db.Close()
 	defer headNode.logFile.Close()
 	if !cleanupDisabled {
 		defer os.RemoveAll(headNode.dir)
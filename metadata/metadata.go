@@ -1,6 +1,9 @@
 package metadata
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/Maelkum/b7s/models/execute"
 )
 
@@ -17,3 +20,23 @@ func (p noopProvider) Metadata(execute.Request, execute.RuntimeOutput) (any, err
 func NewNoopProvider() Provider {
 	return noopProvider{}
 }
+
+// Get returns the metadata for the execution result in its encoded form, as it is included in signed execution results.
+func Get(p Provider, req execute.Request, out execute.RuntimeOutput) (json.RawMessage, error) {
+
+	m, err := p.Metadata(req, out)
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode metadata: %w", err)
+	}
+
+	return encoded, nil
+}
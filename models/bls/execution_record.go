@@ -0,0 +1,28 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// ExecutionRecord describes a past execution request and its outcome.
+type ExecutionRecord struct {
+	RequestID string            `json:"request_id"`
+	Request   execute.Request   `json:"request"`
+	Status    execute.Status    `json:"status"`
+	Code      codes.Code        `json:"code"`
+	Results   execute.ResultMap `json:"results,omitempty"`
+	Cluster   execute.Cluster   `json:"cluster,omitempty"`
+	Message   string            `json:"message,omitempty"`
+	Head      peer.ID           `json:"head,omitempty"` // Head node that handled the request.
+
+	// Results of individual executions, for batch requests.
+	Batch []execute.BatchItemResult `json:"batch,omitempty"`
+
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
@@ -0,0 +1,18 @@
+package bls
+
+import (
+	"errors"
+)
+
+// FailureMessage returns the reason for execution failure, for errors that are communicated to the caller.
+func FailureMessage(err error) string {
+
+	if errors.Is(err, ErrRollCallTimeout) ||
+		errors.Is(err, ErrExecutionNotEnoughNodes) ||
+		errors.Is(err, ErrExecutionCancelled) ||
+		errors.Is(err, ErrRetriesExhausted) {
+		return err.Error()
+	}
+
+	return ""
+}
@@ -0,0 +1,28 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// FunctionInstall describes the outcome of a function install request, as reported by worker nodes.
+type FunctionInstall struct {
+	RequestID string `json:"request_id"`
+	CID       string `json:"cid"`
+
+	// Done is false while install responses are still being collected.
+	Done bool `json:"done"`
+
+	Succeeded []peer.ID                `json:"succeeded"` // Peers that installed the function.
+	Failed    []FunctionInstallFailure `json:"failed"`    // Peers that reported an error.
+	NoAnswer  []peer.ID                `json:"no_answer"` // Known workers on the topic that did not respond in time.
+
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+}
+
+// FunctionInstallFailure describes a failed function install or uninstall on a worker node.
+type FunctionInstallFailure struct {
+	Peer  peer.ID `json:"peer"`
+	Error string  `json:"error,omitempty"`
+}
@@ -14,3 +14,24 @@ type FunctionRecord struct {
 	UpdatedAt     time.Time `json:"updated_at"`
 	LastRetrieved time.Time `json:"last_retrieved"`
 }
+
+// InstalledFunction describes a function installed on a worker node, as reported to the head node.
+type InstalledFunction struct {
+	CID           string    `json:"cid"`
+	Name          string    `json:"name,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	LastRetrieved time.Time `json:"last_retrieved"`
+}
+
+// Installed returns the description of the installed function, without details local to the worker node.
+func (r FunctionRecord) Installed() InstalledFunction {
+
+	fn := InstalledFunction{
+		CID:           r.CID,
+		Name:          r.Manifest.Name,
+		UpdatedAt:     r.UpdatedAt,
+		LastRetrieved: r.LastRetrieved,
+	}
+
+	return fn
+}
@@ -0,0 +1,19 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// FunctionUninstall describes the outcome of a function uninstall request, as reported by worker nodes.
+type FunctionUninstall struct {
+	RequestID string `json:"request_id"`
+	CID       string `json:"cid"`
+
+	Succeeded []peer.ID                `json:"succeeded"` // Peers that uninstalled the function.
+	Failed    []FunctionInstallFailure `json:"failed"`    // Peers that reported an error, e.g. because the function is being executed.
+	NoAnswer  []peer.ID                `json:"no_answer"` // Known workers on the topic that did not respond in time.
+
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+}
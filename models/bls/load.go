@@ -0,0 +1,8 @@
+package bls
+
+// Load describes how busy the node is processing published messages.
+type Load struct {
+	Active uint // Number of messages being processed.
+	Limit  uint // Maximum number of messages processed in parallel.
+	Queued uint // Number of messages waiting for a free processing slot.
+}
@@ -10,18 +10,25 @@ type Message interface {
 
 // Message types in the bls protocol.
 const (
-	MessageHealthCheck             = "MsgHealthCheck"
-	MessageInstallFunction         = "MsgInstallFunction"
-	MessageInstallFunctionResponse = "MsgInstallFunctionResponse"
-	MessageRollCall                = "MsgRollCall"
-	MessageRollCallResponse        = "MsgRollCallResponse"
-	MessageExecute                 = "MsgExecute" // MessageExecute is the execution request, as expected by the head node.
-	MessageExecuteResponse         = "MsgExecuteResponse"
-	MessageWorkOrder               = "MsgWorkOrder" // MessageWorkOrder is the execution request, as expected by the worker node.
-	MessageWorkOrderResponse       = "MsgWorkOrderResponse"
-	MessageFormCluster             = "MsgFormCluster"
-	MessageFormClusterResponse     = "MsgFormClusterResponse"
-	MessageDisbandCluster          = "MsgDisbandCluster"
+	MessageHealthCheck               = "MsgHealthCheck"
+	MessageInstallFunction           = "MsgInstallFunction"
+	MessageInstallFunctionResponse   = "MsgInstallFunctionResponse"
+	MessageUninstallFunction         = "MsgUninstallFunction"
+	MessageUninstallFunctionResponse = "MsgUninstallFunctionResponse"
+	MessageRollCall                  = "MsgRollCall"
+	MessageRollCallResponse          = "MsgRollCallResponse"
+	MessageExecute                   = "MsgExecute" // MessageExecute is the execution request, as expected by the head node.
+	MessageExecuteResponse           = "MsgExecuteResponse"
+	MessageWorkOrder                 = "MsgWorkOrder" // MessageWorkOrder is the execution request, as expected by the worker node.
+	MessageWorkOrderResponse         = "MsgWorkOrderResponse"
+	MessageFormCluster               = "MsgFormCluster"
+	MessageFormClusterResponse       = "MsgFormClusterResponse"
+	MessageDisbandCluster            = "MsgDisbandCluster"
+	MessageCancelExecution           = "MsgCancelExecution"
+	MessageCancelExecutionResponse   = "MsgCancelExecutionResponse"
+	MessageExecutionState            = "MsgExecutionState"
+	MessageFunctionInventory         = "MsgFunctionInventory"
+	MessageFunctionInventoryResponse = "MsgFunctionInventoryResponse"
 )
 
 type TraceableMessage interface {
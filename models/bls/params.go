@@ -12,6 +12,13 @@ var (
 	ErrNotFound                = errors.New("not found")
 	ErrRollCallTimeout         = errors.New("roll call timed out - not enough nodes responded")
 	ErrExecutionNotEnoughNodes = errors.New("not enough execution results received")
+	ErrExecutionCancelled      = errors.New("execution cancelled")
+	ErrTooManyRequests         = errors.New("too many requests")
+	ErrExecutionOrphaned       = errors.New("head node stopped before the execution completed")
+	ErrIdempotencyKeyMismatch  = errors.New("idempotency key was already used for a different request")
+	ErrPeersNotConnected       = errors.New("none of the requested peers are connected")
+	ErrExecutionNotOwned       = errors.New("execution is handled by a different head node")
+	ErrRetriesExhausted        = errors.New("no peer executed the request successfully after all retries")
 )
 
 const (
@@ -0,0 +1,82 @@
+package bls
+
+import (
+	"math"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Penalties applied to the reputation score for different kinds of worker misbehavior.
+// Invalid signatures indicate a faulty or malicious node so they weigh the most.
+const (
+	penaltyFailure          = 1.0
+	penaltyTimeout          = 1.0
+	penaltyNoResult         = 2.0
+	penaltyDisagreement     = 2.0
+	penaltyInvalidSignature = 5.0
+)
+
+// latencyWeight is the weight of the most recent execution latency in the latency moving average.
+const latencyWeight = 0.2
+
+// Reputation describes how well a worker node performed on past executions.
+// Counters decay over time, so recent behavior matters more than old one.
+type Reputation struct {
+	Peer peer.ID `json:"peer"`
+
+	Successes         float64 `json:"successes"`          // Executions that completed successfully.
+	Failures          float64 `json:"failures"`           // Executions that completed with an error.
+	Timeouts          float64 `json:"timeouts"`           // Executions that timed out on the worker.
+	NoResults         float64 `json:"no_results"`         // Roll calls the peer accepted but never sent back a result.
+	Disagreements     float64 `json:"disagreements"`      // Results that differ from the majority result.
+	InvalidSignatures float64 `json:"invalid_signatures"` // Results with an invalid signature.
+
+	// Moving average of the time it took the peer to return a result.
+	Latency time.Duration `json:"latency"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Score returns the reputation score of the peer, in the range (0, 1]. Peers with no recorded failures have the score of 1.
+func (r Reputation) Score() float64 {
+
+	penalty := r.Failures*penaltyFailure +
+		r.Timeouts*penaltyTimeout +
+		r.NoResults*penaltyNoResult +
+		r.Disagreements*penaltyDisagreement +
+		r.InvalidSignatures*penaltyInvalidSignature
+
+	return (r.Successes + 1) / (r.Successes + penalty + 1)
+}
+
+// Decay returns the reputation with its counters decayed to the given time. Counters are halved for every half-life that passed since the last update.
+func (r Reputation) Decay(now time.Time, halfLife time.Duration) Reputation {
+
+	if r.UpdatedAt.IsZero() || halfLife <= 0 || !now.After(r.UpdatedAt) {
+		return r
+	}
+
+	factor := math.Pow(0.5, float64(now.Sub(r.UpdatedAt))/float64(halfLife))
+
+	r.Successes *= factor
+	r.Failures *= factor
+	r.Timeouts *= factor
+	r.NoResults *= factor
+	r.Disagreements *= factor
+	r.InvalidSignatures *= factor
+	r.UpdatedAt = now
+
+	return r
+}
+
+// RecordLatency updates the latency moving average with the given sample.
+func (r *Reputation) RecordLatency(d time.Duration) {
+
+	if r.Latency == 0 {
+		r.Latency = d
+		return
+	}
+
+	r.Latency = time.Duration((1-latencyWeight)*float64(r.Latency) + latencyWeight*float64(d))
+}
@@ -0,0 +1,59 @@
+package bls
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// Schedule describes a recurring execution managed by the head node.
+type Schedule struct {
+	ID      string          `json:"id"`
+	Cron    string          `json:"cron"` // Standard five field cron expression, or a descriptor like `@hourly` or `@every 10m`.
+	Request execute.Request `json:"request"`
+	Topic   string          `json:"topic,omitempty"`
+	Paused  bool            `json:"paused,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	// Outcomes of the most recent runs, oldest first.
+	History []ScheduleRun `json:"history,omitempty"`
+}
+
+// ScheduleRun describes the outcome of a single scheduled execution.
+type ScheduleRun struct {
+	RequestID   string     `json:"request_id"`
+	Code        codes.Code `json:"code"`
+	Message     string     `json:"message,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt time.Time  `json:"completed_at"`
+}
+
+func (s Schedule) Valid() error {
+
+	_, err := ParseCron(s.Cron)
+	if err != nil {
+		return err
+	}
+
+	err = s.Request.Valid()
+	if err != nil {
+		return fmt.Errorf("invalid execution request: %w", err)
+	}
+
+	return nil
+}
+
+// ParseCron parses the cron expression of a schedule.
+func ParseCron(expr string) (cron.Schedule, error) {
+
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	return schedule, nil
+}
@@ -9,6 +9,9 @@ import (
 type Store interface {
 	PeerStore
 	FunctionStore
+	ExecutionStore
+	ScheduleStore
+	ReputationStore
 }
 
 type PeerStore interface {
@@ -24,3 +27,24 @@ type FunctionStore interface {
 	RetrieveFunctions(ctx context.Context) ([]FunctionRecord, error)
 	RemoveFunction(ctx context.Context, id string) error
 }
+
+type ExecutionStore interface {
+	SaveExecution(ctx context.Context, record ExecutionRecord) error
+	RetrieveExecution(ctx context.Context, requestID string) (ExecutionRecord, error)
+	RetrieveExecutions(ctx context.Context) ([]ExecutionRecord, error)
+	RemoveExecution(ctx context.Context, requestID string) error
+}
+
+type ScheduleStore interface {
+	SaveSchedule(ctx context.Context, schedule Schedule) error
+	RetrieveSchedule(ctx context.Context, id string) (Schedule, error)
+	RetrieveSchedules(ctx context.Context) ([]Schedule, error)
+	RemoveSchedule(ctx context.Context, id string) error
+}
+
+type ReputationStore interface {
+	SaveReputation(ctx context.Context, reputation Reputation) error
+	RetrieveReputation(ctx context.Context, id peer.ID) (Reputation, error)
+	RetrieveReputations(ctx context.Context) ([]Reputation, error)
+	RemoveReputation(ctx context.Context, id peer.ID) error
+}
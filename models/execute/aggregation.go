@@ -0,0 +1,62 @@
+package execute
+
+import (
+	"fmt"
+)
+
+// Result aggregation strategies, describing how the head node groups results of individual executions.
+const (
+	AggregationExact     = "exact"      // Results are grouped if the outputs are identical.
+	AggregationStdout    = "stdout"     // Results are grouped if the standard output and exit code are identical, standard error is ignored.
+	AggregationJSON      = "json"       // Results are grouped if the standard output is the same JSON value, regardless of formatting and key order.
+	AggregationJSONField = "json_field" // Results are grouped if the selected field of the standard output JSON has the same value.
+	AggregationMedian    = "median"     // Numeric outputs are combined into their median.
+	AggregationMean      = "mean"       // Numeric outputs are combined into their mean.
+)
+
+// AggregationFieldParameter is the name of the parameter selecting the JSON field for the `json_field` strategy.
+// Nested fields are selected using a dot-separated path, e.g. `data.value`.
+const AggregationFieldParameter = "field"
+
+func (a ResultAggregation) Valid() error {
+
+	if !a.Enable {
+		return nil
+	}
+
+	switch a.Type {
+	case "", AggregationExact, AggregationStdout, AggregationJSON, AggregationMedian, AggregationMean:
+		return nil
+
+	case AggregationJSONField:
+		if a.Field() == "" {
+			return fmt.Errorf("parameter %q is required for %v result aggregation", AggregationFieldParameter, a.Type)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown result aggregation type: %v", a.Type)
+	}
+}
+
+// Strategy returns the aggregation strategy that applies. Results are matched exactly, unless a valid strategy is requested.
+func (a ResultAggregation) Strategy() string {
+
+	if !a.Enable || a.Type == "" || a.Valid() != nil {
+		return AggregationExact
+	}
+
+	return a.Type
+}
+
+// Field returns the path of the JSON field used by the `json_field` strategy.
+func (a ResultAggregation) Field() string {
+
+	for _, param := range a.Parameters {
+		if param.Name == AggregationFieldParameter {
+			return param.Value
+		}
+	}
+
+	return ""
+}
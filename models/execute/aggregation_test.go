@@ -0,0 +1,24 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultAggregation(t *testing.T) {
+
+	field := Parameter{Name: AggregationFieldParameter, Value: "data.value"}
+
+	require.NoError(t, ResultAggregation{}.Valid())
+	require.NoError(t, ResultAggregation{Enable: true, Type: AggregationMedian}.Valid())
+	require.NoError(t, ResultAggregation{Enable: true, Type: AggregationJSONField, Parameters: []Parameter{field}}.Valid())
+
+	require.Error(t, ResultAggregation{Enable: true, Type: "unknown"}.Valid())
+	require.Error(t, ResultAggregation{Enable: true, Type: AggregationJSONField}.Valid())
+
+	require.Equal(t, AggregationExact, ResultAggregation{}.Strategy())
+	require.Equal(t, AggregationExact, ResultAggregation{Type: AggregationMean}.Strategy())
+	require.Equal(t, AggregationMean, ResultAggregation{Enable: true, Type: AggregationMean}.Strategy())
+	require.Equal(t, "data.value", ResultAggregation{Parameters: []Parameter{field}}.Field())
+}
@@ -0,0 +1,78 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/codes"
+)
+
+// MaxBatchItems is the maximum number of items in a single batch.
+const MaxBatchItems = 1000
+
+// Batch describes many executions of the same function, with different inputs.
+// Batches are executed without consensus.
+type Batch struct {
+	// Request describes the function, method and configuration shared by all batch items.
+	Request
+	Items []BatchItem `json:"items"`
+}
+
+// BatchItem describes inputs of a single execution in a batch.
+type BatchItem struct {
+	// Parameters are appended to the parameters of the batch request.
+	Parameters []Parameter `json:"parameters,omitempty"`
+	// Environment variables are added to the environment variables of the batch request.
+	Environment []EnvVar `json:"env_vars,omitempty"`
+	// Stdin overrides the standard input of the batch request, if set.
+	Stdin *string `json:"stdin,omitempty"`
+}
+
+// BatchItemResult describes the outcome of a single execution in a batch. Code is empty for items not yet executed.
+type BatchItemResult struct {
+	RequestID string        `json:"request_id"`
+	Code      codes.Code    `json:"code,omitempty"`
+	Peer      peer.ID       `json:"peer,omitempty"`
+	Result    RuntimeOutput `json:"result,omitempty"`
+	Message   string        `json:"message,omitempty"`
+}
+
+func (b Batch) Valid() error {
+
+	err := b.Request.Valid()
+	if err != nil {
+		return err
+	}
+
+	if len(b.Items) == 0 {
+		return errors.New("batch has no items")
+	}
+
+	if len(b.Items) > MaxBatchItems {
+		return fmt.Errorf("too many batch items (have: %v, max: %v)", len(b.Items), MaxBatchItems)
+	}
+
+	if b.Config.ConsensusAlgorithm != "" {
+		return fmt.Errorf("batch execution does not support consensus (have: %v)", b.Config.ConsensusAlgorithm)
+	}
+
+	return nil
+}
+
+// Item returns the execution request for the batch item with the given index.
+func (b Batch) Item(i int) Request {
+
+	item := b.Items[i]
+
+	req := b.Request
+	req.Parameters = slices.Concat(b.Parameters, item.Parameters)
+	req.Config.Environment = slices.Concat(b.Config.Environment, item.Environment)
+	if item.Stdin != nil {
+		req.Config.Stdin = item.Stdin
+	}
+
+	return req
+}
@@ -0,0 +1,79 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch_Valid(t *testing.T) {
+
+	batch := Batch{
+		Request: Request{
+			FunctionID: "function-id",
+			Method:     "method-value",
+		},
+		Items: []BatchItem{{}},
+	}
+
+	require.NoError(t, batch.Valid())
+
+	empty := batch
+	empty.Items = nil
+	require.Error(t, empty.Valid())
+
+	consensus := batch
+	consensus.Config.ConsensusAlgorithm = "raft"
+	require.Error(t, consensus.Valid())
+
+	full := batch
+	full.Items = make([]BatchItem, MaxBatchItems)
+	require.NoError(t, full.Valid())
+
+	tooLarge := batch
+	tooLarge.Items = make([]BatchItem, MaxBatchItems+1)
+	require.Error(t, tooLarge.Valid())
+}
+
+func TestBatch_Item(t *testing.T) {
+
+	var (
+		stdin     = "batch-stdin"
+		itemStdin = "item-stdin"
+	)
+
+	batch := Batch{
+		Request: Request{
+			FunctionID: "function-id",
+			Method:     "method-value",
+			Parameters: []Parameter{{Value: "--flag"}},
+			Config: Config{
+				Environment: []EnvVar{{Name: "SHARED", Value: "shared-value"}},
+				Stdin:       &stdin,
+			},
+		},
+		Items: []BatchItem{
+			{
+				Parameters:  []Parameter{{Value: "first"}},
+				Environment: []EnvVar{{Name: "ITEM", Value: "first"}},
+			},
+			{
+				Parameters: []Parameter{{Value: "second"}},
+				Stdin:      &itemStdin,
+			},
+		},
+	}
+
+	first := batch.Item(0)
+	require.Equal(t, []Parameter{{Value: "--flag"}, {Value: "first"}}, first.Parameters)
+	require.Equal(t, []EnvVar{{Name: "SHARED", Value: "shared-value"}, {Name: "ITEM", Value: "first"}}, first.Config.Environment)
+	require.Equal(t, stdin, *first.Config.Stdin)
+
+	second := batch.Item(1)
+	require.Equal(t, []Parameter{{Value: "--flag"}, {Value: "second"}}, second.Parameters)
+	require.Equal(t, itemStdin, *second.Config.Stdin)
+
+	// Batch request is not modified.
+	require.Equal(t, []Parameter{{Value: "--flag"}}, batch.Parameters)
+	require.Len(t, batch.Config.Environment, 1)
+}
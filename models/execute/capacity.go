@@ -0,0 +1,11 @@
+package execute
+
+// Capacity describes how much work a worker node can take on, as reported in its roll call response.
+type Capacity struct {
+	Slots      uint `json:"slots"`      // Maximum number of messages the node processes in parallel.
+	FreeSlots  uint `json:"free_slots"` // Number of processing slots currently free.
+	Queued     uint `json:"queued"`     // Number of messages waiting for a free processing slot.
+	Executions uint `json:"executions"` // Number of executions in progress.
+	Clusters   uint `json:"clusters"`   // Number of consensus clusters the node is part of.
+	Cached     bool `json:"cached"`     // Function was already installed, so nothing had to be downloaded.
+}
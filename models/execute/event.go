@@ -0,0 +1,26 @@
+package execute
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/codes"
+)
+
+// EventType describes what kind of progress was made on an execution request.
+type EventType string
+
+const (
+	EventStatus              EventType = "status"               // Execution request moved to a different stage.
+	EventRollCall            EventType = "roll_call"            // Peer was chosen for execution in a roll call.
+	EventClusterConfirmation EventType = "cluster_confirmation" // Peer responded to a cluster formation request.
+	EventResult              EventType = "result"               // Peer execution result was accounted.
+)
+
+// Event describes progress of an execution request.
+type Event struct {
+	Type      EventType  `json:"type"`
+	RequestID string     `json:"request_id"`
+	Status    Status     `json:"status,omitempty"`
+	Peer      peer.ID    `json:"peer,omitempty"`
+	Code      codes.Code `json:"code,omitempty"`
+}
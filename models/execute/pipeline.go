@@ -0,0 +1,112 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/Maelkum/b7s/models/codes"
+)
+
+// Input mapping types, describing how output of the previous pipeline step is passed to the next one.
+const (
+	InputStdin     = "stdin"     // Output is passed as standard input.
+	InputEnv       = "env"       // Output is passed as an environment variable.
+	InputParameter = "parameter" // Output is passed as a function argument.
+)
+
+// Pipeline is an ordered list of executions, where output of one step can be passed to the next one.
+type Pipeline []PipelineStep
+
+// PipelineStep describes a single execution in a pipeline.
+type PipelineStep struct {
+	Request
+
+	// Input describes how the output of the previous step is passed to this step.
+	Input InputMapping `json:"input,omitempty"`
+	// Optional steps do not stop the pipeline if they fail.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// InputMapping describes how the output of the previous pipeline step is passed to a step.
+type InputMapping struct {
+	Type string `json:"type,omitempty"`
+	// Name of the environment variable. For parameters, name is passed as an argument preceding the value, if set.
+	Name string `json:"name,omitempty"`
+}
+
+// PipelineStepResult describes the outcome of a single pipeline step.
+type PipelineStepResult struct {
+	RequestID string     `json:"request_id"`
+	Code      codes.Code `json:"code"`
+	Results   ResultMap  `json:"results,omitempty"`
+	Cluster   Cluster    `json:"cluster,omitempty"`
+	Message   string     `json:"message,omitempty"`
+}
+
+func (p Pipeline) Valid() error {
+
+	if len(p) == 0 {
+		return errors.New("pipeline has no steps")
+	}
+
+	var multierr *multierror.Error
+	for i, step := range p {
+
+		err := step.Request.Valid()
+		if err != nil {
+			multierr = multierror.Append(multierr, fmt.Errorf("step %d: %w", i, err))
+		}
+
+		if i == 0 && step.Input.Type != "" {
+			multierr = multierror.Append(multierr, errors.New("step 0: first step has no input to map"))
+		}
+
+		err = step.Input.Valid()
+		if err != nil {
+			multierr = multierror.Append(multierr, fmt.Errorf("step %d: %w", i, err))
+		}
+	}
+
+	return multierr.ErrorOrNil()
+}
+
+func (m InputMapping) Valid() error {
+
+	switch m.Type {
+	case "", InputStdin, InputParameter:
+		return nil
+
+	case InputEnv:
+		if m.Name == "" {
+			return errors.New("environment variable name is required")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown input mapping type: %v", m.Type)
+	}
+}
+
+// Apply returns the request with the input passed as described by the input mapping.
+func (m InputMapping) Apply(req Request, input string) Request {
+
+	switch m.Type {
+	case InputStdin:
+		req.Config.Stdin = &input
+
+	case InputEnv:
+		req.Config.Environment = append(slices.Clone(req.Config.Environment), EnvVar{Name: m.Name, Value: input})
+
+	case InputParameter:
+		params := slices.Clone(req.Parameters)
+		if m.Name != "" {
+			params = append(params, Parameter{Value: m.Name})
+		}
+		req.Parameters = append(params, Parameter{Value: input})
+	}
+
+	return req
+}
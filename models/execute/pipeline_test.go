@@ -0,0 +1,56 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline_Valid(t *testing.T) {
+
+	step := PipelineStep{
+		Request: Request{
+			FunctionID: "function-id",
+			Method:     "method-value",
+		},
+	}
+
+	withInput := func(input InputMapping) PipelineStep {
+		s := step
+		s.Input = input
+		return s
+	}
+
+	require.NoError(t, Pipeline{step, withInput(InputMapping{Type: InputStdin})}.Valid())
+	require.NoError(t, Pipeline{step, withInput(InputMapping{Type: InputEnv, Name: "INPUT"})}.Valid())
+
+	require.Error(t, Pipeline{}.Valid())
+	require.Error(t, Pipeline{withInput(InputMapping{Type: InputStdin})}.Valid())
+	require.Error(t, Pipeline{step, withInput(InputMapping{Type: InputEnv})}.Valid())
+	require.Error(t, Pipeline{step, withInput(InputMapping{Type: "unknown"})}.Valid())
+}
+
+func TestInputMapping_Apply(t *testing.T) {
+
+	req := Request{
+		FunctionID: "function-id",
+		Method:     "method-value",
+		Parameters: []Parameter{{Value: "--flag"}},
+	}
+
+	const input = "input-value"
+
+	stdin := InputMapping{Type: InputStdin}.Apply(req, input)
+	require.NotNil(t, stdin.Config.Stdin)
+	require.Equal(t, input, *stdin.Config.Stdin)
+
+	env := InputMapping{Type: InputEnv, Name: "INPUT"}.Apply(req, input)
+	require.Equal(t, []EnvVar{{Name: "INPUT", Value: input}}, env.Config.Environment)
+
+	param := InputMapping{Type: InputParameter}.Apply(req, input)
+	require.Equal(t, []Parameter{{Value: "--flag"}, {Value: input}}, param.Parameters)
+
+	// Original request is not modified.
+	require.Nil(t, req.Config.Stdin)
+	require.Len(t, req.Parameters, 1)
+}
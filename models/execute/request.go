@@ -2,8 +2,11 @@ package execute
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 // Request describes an execution request.
@@ -29,6 +32,39 @@ func (r Request) Valid() error {
 		err = multierror.Append(err, errors.New("method is required"))
 	}
 
+	if r.Config.AgreementThreshold < 0 || r.Config.AgreementThreshold > 1 {
+		err = multierror.Append(err, fmt.Errorf("agreement threshold must be between 0 and 1 (have: %v)", r.Config.AgreementThreshold))
+	}
+
+	if len(r.Config.IncludePeers) > 0 && r.Config.NodeCount > len(r.Config.IncludePeers) {
+		err = multierror.Append(err, fmt.Errorf("node count larger than the number of included peers (have: %v, need: %v)", len(r.Config.IncludePeers), r.Config.NodeCount))
+	}
+
+	for _, id := range r.Config.IncludePeers {
+		if slices.Contains(r.Config.ExcludePeers, id) {
+			err = multierror.Append(err, fmt.Errorf("peer both included and excluded (peer: %s)", id))
+		}
+	}
+
+	if r.Config.Attributes != nil {
+		attrErr := r.Config.Attributes.Valid()
+		if attrErr != nil {
+			err = multierror.Append(err, attrErr)
+		}
+	}
+
+	aggrErr := r.Config.ResultAggregation.Valid()
+	if aggrErr != nil {
+		err = multierror.Append(err, aggrErr)
+	}
+
+	if r.Config.Retry != nil {
+		retryErr := r.Config.Retry.Valid()
+		if retryErr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid retry policy: %w", retryErr))
+		}
+	}
+
 	return err.ErrorOrNil()
 }
 
@@ -59,6 +95,26 @@ type Config struct {
 
 	// Threshold (percentage) defines how many nodes should respond with a result to consider this execution successful.
 	Threshold float64 `json:"threshold,omitempty"`
+
+	// AgreementThreshold defines how many of the results should agree (after aggregation) to consider this execution successful.
+	// Zero means results don't have to agree. Does not apply to executions with PBFT consensus.
+	// With numeric (median or mean) aggregation, results have to match exactly to agree.
+	AgreementThreshold float64 `json:"agreement_threshold,omitempty"`
+
+	// Strategy used to choose among peers that reported for roll call.
+	SelectionStrategy string `json:"selection_strategy,omitempty"`
+
+	// Retry policy for replacing workers that fail to execute the request.
+	Retry *RetryPolicy `json:"retry,omitempty"`
+
+	// BypassCache requests execution even if the head node has a cached result for an identical request.
+	BypassCache bool `json:"bypass_cache,omitempty"`
+
+	// IncludePeers restricts execution to the given peers. Roll call is sent directly to these peers instead of being published on the topic.
+	IncludePeers []peer.ID `json:"include_peers,omitempty"`
+
+	// ExcludePeers lists peers that should not execute the request.
+	ExcludePeers []peer.ID `json:"exclude_peers,omitempty"`
 }
 
 // EnvVar represents the name and value of the environment variables set for the execution.
@@ -1,14 +1,37 @@
 package execute
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
 
+// Attribute operators, describing how the attribute value of a node is compared to the wanted value.
+const (
+	AttributeEqual          = "eq"     // Attribute value is equal to the wanted value.
+	AttributeNotEqual       = "neq"    // Attribute value is different from the wanted value.
+	AttributeGreater        = "gt"     // Numeric attribute value is greater than the wanted value.
+	AttributeGreaterOrEqual = "gte"    // Numeric attribute value is greater than or equal to the wanted value.
+	AttributeLess           = "lt"     // Numeric attribute value is less than the wanted value.
+	AttributeLessOrEqual    = "lte"    // Numeric attribute value is less than or equal to the wanted value.
+	AttributeIn             = "in"     // Attribute value is one of the wanted values.
+	AttributePrefix         = "prefix" // Attribute value starts with the wanted value.
+	AttributeRegex          = "regex"  // Attribute value matches the wanted regular expression.
+)
+
 type Attributes struct {
-	// Values specify which attributes the node in question should have.
-	// At the moment we support strict equality only, so no `if RAM >= 16GB` types of conditions.
+	// Values specify which attributes the node in question should have, with the exact value.
 	Values []Parameter `json:"values,omitempty"`
 
+	// Requirements specify conditions that attributes of the node in question should satisfy.
+	Requirements []AttributeRequirement `json:"requirements,omitempty"`
+
 	// Should we accept nodes whose attributes are not attested?
 	AttestationRequired bool `json:"attestation_required,omitempty"`
 
@@ -23,3 +46,112 @@ type AttributeAttestors struct {
 	// Any one of these attestors should be found.
 	OneOf []peer.ID `json:"one_of,omitempty"`
 }
+
+// AttributeRequirement describes a condition that an attribute of the node should satisfy.
+type AttributeRequirement struct {
+	Name     string `json:"name"`
+	Operator string `json:"operator"`
+
+	// Value is compared to the attribute value for all operators, except `in`.
+	Value string `json:"value,omitempty"`
+	// Values lists the accepted attribute values for the `in` operator.
+	Values []string `json:"values,omitempty"`
+}
+
+func (a Attributes) Valid() error {
+
+	var err *multierror.Error
+	for i, requirement := range a.Requirements {
+		rerr := requirement.Valid()
+		if rerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid attribute requirement %v: %w", i, rerr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+func (r AttributeRequirement) Valid() error {
+
+	if r.Name == "" {
+		return errors.New("attribute name is required")
+	}
+
+	switch r.Operator {
+	case AttributeEqual, AttributeNotEqual, AttributePrefix:
+		return nil
+
+	case AttributeGreater, AttributeGreaterOrEqual, AttributeLess, AttributeLessOrEqual:
+		_, err := strconv.ParseFloat(r.Value, 64)
+		if err != nil {
+			return fmt.Errorf("numeric value required for %v operator (value: %v)", r.Operator, r.Value)
+		}
+		return nil
+
+	case AttributeIn:
+		if len(r.Values) == 0 {
+			return fmt.Errorf("values required for %v operator", r.Operator)
+		}
+		return nil
+
+	case AttributeRegex:
+		_, err := regexp.Compile(r.Value)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown attribute operator: %v", r.Operator)
+	}
+}
+
+// Match checks if the given attribute value satisfies the requirement.
+func (r AttributeRequirement) Match(value string) (bool, error) {
+
+	switch r.Operator {
+	case AttributeEqual:
+		return value == r.Value, nil
+
+	case AttributeNotEqual:
+		return value != r.Value, nil
+
+	case AttributePrefix:
+		return strings.HasPrefix(value, r.Value), nil
+
+	case AttributeIn:
+		return slices.Contains(r.Values, value), nil
+
+	case AttributeRegex:
+		re, err := regexp.Compile(r.Value)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return re.MatchString(value), nil
+
+	case AttributeGreater, AttributeGreaterOrEqual, AttributeLess, AttributeLessOrEqual:
+		want, err := strconv.ParseFloat(r.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("could not parse wanted value as a number (value: %v): %w", r.Value, err)
+		}
+
+		have, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false, fmt.Errorf("could not parse attribute value as a number (value: %v): %w", value, err)
+		}
+
+		switch r.Operator {
+		case AttributeGreater:
+			return have > want, nil
+		case AttributeGreaterOrEqual:
+			return have >= want, nil
+		case AttributeLess:
+			return have < want, nil
+		default:
+			return have <= want, nil
+		}
+
+	default:
+		return false, fmt.Errorf("unknown attribute operator: %v", r.Operator)
+	}
+}
@@ -0,0 +1,54 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeRequirement_Valid(t *testing.T) {
+
+	valid := []AttributeRequirement{
+		{Name: "region", Operator: AttributeEqual, Value: "eu-west"},
+		{Name: "region", Operator: AttributeNotEqual, Value: "eu-west"},
+		{Name: "ram", Operator: AttributeGreater, Value: "16"},
+		{Name: "ram", Operator: AttributeLessOrEqual, Value: "16.5"},
+		{Name: "region", Operator: AttributeIn, Values: []string{"eu-west", "eu-central"}},
+		{Name: "gpu", Operator: AttributePrefix, Value: "nvidia"},
+		{Name: "gpu", Operator: AttributeRegex, Value: "^nvidia-.*$"},
+	}
+	for _, requirement := range valid {
+		require.NoError(t, requirement.Valid(), "requirement: %+v", requirement)
+	}
+
+	invalid := []AttributeRequirement{
+		{Operator: AttributeEqual, Value: "eu-west"},
+		{Name: "region", Operator: "like", Value: "eu-west"},
+		{Name: "ram", Operator: AttributeGreaterOrEqual, Value: "16GB"},
+		{Name: "region", Operator: AttributeIn},
+		{Name: "gpu", Operator: AttributeRegex, Value: "nvidia-("},
+	}
+	for _, requirement := range invalid {
+		require.Error(t, requirement.Valid(), "requirement: %+v", requirement)
+	}
+}
+
+func TestRequest_Valid_AttributeRequirements(t *testing.T) {
+
+	req := Request{
+		FunctionID: "function-id",
+		Method:     "method-value",
+		Config: Config{
+			Attributes: &Attributes{
+				Values: []Parameter{{Name: "region", Value: "eu-west"}},
+				Requirements: []AttributeRequirement{
+					{Name: "ram", Operator: AttributeGreaterOrEqual, Value: "16"},
+				},
+			},
+		},
+	}
+	require.NoError(t, req.Valid())
+
+	req.Config.Attributes.Requirements = append(req.Config.Attributes.Requirements, AttributeRequirement{Name: "ram", Operator: AttributeGreaterOrEqual, Value: "16GB"})
+	require.Error(t, req.Valid())
+}
@@ -0,0 +1,55 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequest_Valid_TargetedPeers(t *testing.T) {
+
+	var (
+		first  = peer.ID("first-peer")
+		second = peer.ID("second-peer")
+	)
+
+	req := Request{
+		FunctionID: "function-id",
+		Method:     "method-value",
+		Config: Config{
+			NodeCount:    1,
+			IncludePeers: []peer.ID{first},
+			ExcludePeers: []peer.ID{second},
+		},
+	}
+	require.NoError(t, req.Valid())
+
+	// Not enough included peers.
+	req.Config.NodeCount = 2
+	require.Error(t, req.Valid())
+
+	// Peer both included and excluded.
+	req.Config.NodeCount = 1
+	req.Config.ExcludePeers = []peer.ID{first}
+	require.Error(t, req.Valid())
+}
+
+func TestRequest_Valid_RetryPolicy(t *testing.T) {
+
+	req := Request{
+		FunctionID: "function-id",
+		Method:     "method-value",
+		Config: Config{
+			Retry: &RetryPolicy{MaxAttempts: 2, Backoff: 100},
+		},
+	}
+	require.NoError(t, req.Valid())
+
+	req.Config.Retry.MaxAttempts = MaxRetryAttempts + 1
+	require.Error(t, req.Valid())
+
+	req.Config.Retry.MaxAttempts = 2
+	req.Config.Retry.Backoff = MaxRetryBackoff + 1
+	require.Error(t, req.Valid())
+}
@@ -0,0 +1,135 @@
+package execute
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/codes"
+)
+
+// SignedResponse describes the parts of the execution response covered by the head node signature.
+// Results, cluster and batch are kept in their JSON form, as returned to the client, so the response
+// can be verified without decoding them. Insignificant whitespace is ignored.
+type SignedResponse struct {
+	RequestID string          `json:"request_id"`
+	Code      codes.Code      `json:"code"`
+	Results   json.RawMessage `json:"results,omitempty"`
+	Cluster   json.RawMessage `json:"cluster,omitempty"`
+	Batch     json.RawMessage `json:"batch,omitempty"`
+
+	// Head node that signed the response.
+	Head      peer.ID `json:"head"`
+	Signature string  `json:"signature,omitempty"`
+}
+
+// Sign signs the response using the given key. Head is set to the peer ID corresponding to the key.
+func (r *SignedResponse) Sign(key crypto.PrivKey) error {
+
+	head, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("could not determine peer ID from key: %w", err)
+	}
+
+	r.Head = head
+
+	payload, err := r.payload()
+	if err != nil {
+		return fmt.Errorf("could not get byte representation of the response: %w", err)
+	}
+
+	sig, err := key.Sign(payload)
+	if err != nil {
+		return fmt.Errorf("could not sign digest: %w", err)
+	}
+
+	r.Signature = hex.EncodeToString(sig)
+	return nil
+}
+
+// VerifySignature verifies the response signature using the given public key.
+func (r SignedResponse) VerifySignature(key crypto.PubKey) error {
+
+	payload, err := r.payload()
+	if err != nil {
+		return fmt.Errorf("could not get byte representation of the response: %w", err)
+	}
+
+	sig, err := hex.DecodeString(r.Signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature from hex: %w", err)
+	}
+
+	ok, err := key.Verify(payload, sig)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	if !ok {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+func (r SignedResponse) payload() ([]byte, error) {
+
+	cp := r
+	// Exclude signature from the payload.
+	cp.Signature = ""
+
+	var err error
+	for _, field := range []*json.RawMessage{&cp.Results, &cp.Cluster, &cp.Batch} {
+		*field, err = compact(*field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(cp)
+}
+
+func compact(data json.RawMessage) (json.RawMessage, error) {
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	err := json.Compact(&buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not compact JSON: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// VerifyResponse verifies that the JSON encoded execution response was signed by the given head node.
+func VerifyResponse(data []byte, head peer.ID) error {
+
+	var res SignedResponse
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return fmt.Errorf("could not unpack response: %w", err)
+	}
+
+	if res.Signature == "" {
+		return errors.New("response is not signed")
+	}
+
+	if res.Head != head {
+		return fmt.Errorf("response signed by a different head node (have: %s, want: %s)", res.Head, head)
+	}
+
+	key, err := head.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("could not extract public key from peer ID: %w", err)
+	}
+
+	return res.VerifySignature(key)
+}
@@ -17,9 +17,10 @@ import (
 type NodeResult struct {
 	Result
 
-	Signature string         `json:"signature,omitempty"` // Signed digest of the response.
-	PBFT      PBFTResultInfo `json:"pbft,omitempty"`
-	Metadata  any            `json:"metadata,omitempty"`
+	Signature string          `json:"signature,omitempty"` // Signed digest of the response.
+	PBFT      PBFTResultInfo  `json:"pbft,omitempty"`
+	Metadata  json.RawMessage `json:"metadata,omitempty"`  // Kept in its encoded form so the signature can be verified after transport.
+	Cacheable bool            `json:"cacheable,omitempty"` // Function is marked as cacheable in its manifest, so the result can be reused for identical requests.
 }
 
 // Result describes an execution result.
@@ -33,6 +34,15 @@ type Result struct {
 type Cluster struct {
 	Main  peer.ID   `json:"main,omitempty"`
 	Peers []peer.ID `json:"peers,omitempty"`
+
+	// Capacity the peers reported at roll call time.
+	Capacity map[peer.ID]Capacity `json:"capacity,omitempty"`
+
+	// Peers that failed to execute the request, and the peers that replaced them.
+	Replacements []Replacement `json:"replacements,omitempty"`
+
+	// Results were served from the head node result cache. Peers are the ones that executed the original request.
+	Cached bool `json:"cached,omitempty"`
 }
 
 // RuntimeOutput describes the output produced by the Bless Runtime during execution.
@@ -75,15 +85,12 @@ func (m ResultMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(em)
 }
 
-func (r *NodeResult) Sign(key crypto.PrivKey) error {
-
-	cp := *r
-	// Exclude some of the fields from the signature.
-	cp.Signature = ""
+// Sign signs the execution result, binding it to the request it is the result of.
+func (r *NodeResult) Sign(key crypto.PrivKey, requestID string) error {
 
-	payload, err := json.Marshal(cp)
+	payload, err := r.signaturePayload(requestID)
 	if err != nil {
-		return fmt.Errorf("could not get byte representation of the record: %w", err)
+		return err
 	}
 
 	sig, err := key.Sign(payload)
@@ -95,15 +102,12 @@ func (r *NodeResult) Sign(key crypto.PrivKey) error {
 	return nil
 }
 
-func (r NodeResult) VerifySignature(key crypto.PubKey) error {
+// VerifySignature verifies that the execution result was signed with the given key, as the result of the given request.
+func (r NodeResult) VerifySignature(key crypto.PubKey, requestID string) error {
 
-	cp := r
-	// Exclude some of the fields from the signature.
-	cp.Signature = ""
-
-	payload, err := json.Marshal(cp)
+	payload, err := r.signaturePayload(requestID)
 	if err != nil {
-		return fmt.Errorf("could not get byte representation of the record: %w", err)
+		return err
 	}
 
 	sig, err := hex.DecodeString(r.Signature)
@@ -122,3 +126,26 @@ func (r NodeResult) VerifySignature(key crypto.PubKey) error {
 
 	return nil
 }
+
+// signaturePayload returns the bytes signed for the execution result - the result without its signature, and the request ID.
+func (r NodeResult) signaturePayload(requestID string) ([]byte, error) {
+
+	cp := r
+	// Exclude some of the fields from the signature.
+	cp.Signature = ""
+
+	rec := struct {
+		RequestID string     `json:"request_id"`
+		Result    NodeResult `json:"result"`
+	}{
+		RequestID: requestID,
+		Result:    cp,
+	}
+
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("could not get byte representation of the record: %w", err)
+	}
+
+	return payload, nil
+}
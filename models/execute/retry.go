@@ -0,0 +1,64 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Limits for the retry policy, preventing clients from keeping the head node busy with retries.
+const (
+	MaxRetryAttempts = 10              // Maximum number of retry attempts.
+	MaxRetryBackoff  = 60_000          // Maximum initial delay, in milliseconds.
+	MaxRetryDelay    = 5 * time.Minute // Maximum delay before any retry.
+)
+
+// RetryPolicy describes how the head node replaces workers that fail to execute the request.
+// Retries are done only for executions that do not require consensus.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times the head node will look for replacement workers.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// Backoff is the delay before the first retry, in milliseconds. The delay doubles with each subsequent retry.
+	Backoff int `json:"backoff,omitempty"`
+}
+
+func (p RetryPolicy) Valid() error {
+
+	if p.MaxAttempts < 0 || p.Backoff < 0 {
+		return errors.New("retry attempts and backoff cannot be negative")
+	}
+
+	if p.MaxAttempts > MaxRetryAttempts {
+		return fmt.Errorf("too many retry attempts (max: %v)", MaxRetryAttempts)
+	}
+
+	if p.Backoff > MaxRetryBackoff {
+		return fmt.Errorf("retry backoff too long (max: %v ms)", MaxRetryBackoff)
+	}
+
+	return nil
+}
+
+// Delay returns how long to wait before the given retry attempt (starting at 1).
+// The delay is capped at MaxRetryDelay.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+
+	if p.Backoff <= 0 || attempt < 1 {
+		return 0
+	}
+
+	delay := time.Duration(p.Backoff) * time.Millisecond
+	for i := 1; i < attempt && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, MaxRetryDelay)
+}
+
+// Replacement records a peer that failed to execute the request, and the peer that took over its work.
+type Replacement struct {
+	Replaced    peer.ID `json:"replaced"`
+	Replacement peer.ID `json:"replacement"`
+}
@@ -0,0 +1,32 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Valid(t *testing.T) {
+
+	require.NoError(t, RetryPolicy{}.Valid())
+	require.NoError(t, RetryPolicy{MaxAttempts: MaxRetryAttempts, Backoff: MaxRetryBackoff}.Valid())
+
+	require.Error(t, RetryPolicy{MaxAttempts: -1}.Valid())
+	require.Error(t, RetryPolicy{Backoff: -1}.Valid())
+	require.Error(t, RetryPolicy{MaxAttempts: MaxRetryAttempts + 1}.Valid())
+	require.Error(t, RetryPolicy{MaxAttempts: 1, Backoff: MaxRetryBackoff + 1}.Valid())
+}
+
+func TestRetryPolicy_DelayCapped(t *testing.T) {
+
+	policy := RetryPolicy{
+		MaxAttempts: MaxRetryAttempts,
+		Backoff:     MaxRetryBackoff,
+	}
+
+	require.Equal(t, MaxRetryDelay, policy.Delay(MaxRetryAttempts))
+
+	// Large attempt counts must not overflow.
+	require.Equal(t, MaxRetryDelay, policy.Delay(100))
+	require.Equal(t, MaxRetryDelay, RetryPolicy{Backoff: 1}.Delay(1000))
+}
@@ -0,0 +1,9 @@
+package execute
+
+// Selection strategies determine which of the peers that reported for roll call will execute the request.
+const (
+	SelectionFirstCome     = "first-come"     // Peers are chosen in the order their roll call responses arrive.
+	SelectionRandom        = "random"         // Peers are chosen randomly among those that responded within the selection window.
+	SelectionLeastLoaded   = "least-loaded"   // Peers with the least work in progress are chosen.
+	SelectionLowestLatency = "lowest-latency" // Peers with the lowest latency are chosen.
+)
@@ -1,10 +1,12 @@
 package execute
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Maelkum/b7s/models/codes"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 )
 
@@ -76,10 +78,10 @@ func TestResultExecute_Signing(t *testing.T) {
 		res := sampleRes
 		priv, pub := newKey(t)
 
-		err := res.Sign(priv)
+		err := res.Sign(priv, "request-id")
 		require.NoError(t, err)
 
-		err = res.VerifySignature(pub)
+		err = res.VerifySignature(pub, "request-id")
 		require.NoError(t, err)
 	})
 	t.Run("empty signature verification fails", func(t *testing.T) {
@@ -89,7 +91,7 @@ func TestResultExecute_Signing(t *testing.T) {
 
 		_, pub := newKey(t)
 
-		err := res.VerifySignature(pub)
+		err := res.VerifySignature(pub, "request-id")
 		require.Error(t, err)
 	})
 	t.Run("tampered data signature verification fails", func(t *testing.T) {
@@ -97,14 +99,43 @@ func TestResultExecute_Signing(t *testing.T) {
 		res := sampleRes
 		priv, pub := newKey(t)
 
-		err := res.Sign(priv)
+		err := res.Sign(priv, "request-id")
 		require.NoError(t, err)
 
 		res.Result.Result.Stdout += " "
 
-		err = res.VerifySignature(pub)
+		err = res.VerifySignature(pub, "request-id")
 		require.Error(t, err)
 	})
+	t.Run("signature for a different request fails verification", func(t *testing.T) {
+
+		res := sampleRes
+		priv, pub := newKey(t)
+
+		err := res.Sign(priv, "request-id")
+		require.NoError(t, err)
+
+		err = res.VerifySignature(pub, "other-request-id")
+		require.Error(t, err)
+	})
+	t.Run("metadata survives transport", func(t *testing.T) {
+
+		res := sampleRes
+		res.Metadata = json.RawMessage(`{"region":"eu","cost":9007199254740993,"nested":{"b":1,"a":2}}`)
+		priv, pub := newKey(t)
+
+		err := res.Sign(priv, "request-id")
+		require.NoError(t, err)
+
+		payload, err := json.Marshal(res)
+		require.NoError(t, err)
+
+		var received NodeResult
+		require.NoError(t, json.Unmarshal(payload, &received))
+
+		err = received.VerifySignature(pub, "request-id")
+		require.NoError(t, err)
+	})
 }
 
 func newKey(t *testing.T) (crypto.PrivKey, crypto.PubKey) {
@@ -114,3 +145,89 @@ func newKey(t *testing.T) (crypto.PrivKey, crypto.PubKey) {
 
 	return priv, pub
 }
+
+func TestResponse_Signing(t *testing.T) {
+
+	sampleRes := SignedResponse{
+		RequestID: "request-id",
+		Code:      codes.OK,
+		Results:   json.RawMessage(`[{"result":{"stdout":"generic-execution-result","stderr":"","exit_code":0},"frequency":100}]`),
+		Cluster:   json.RawMessage(`{"peers":["peer-id"]}`),
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		res := sampleRes
+		priv, _ := newKey(t)
+
+		err := res.Sign(priv)
+		require.NoError(t, err)
+
+		head, err := peer.IDFromPrivateKey(priv)
+		require.NoError(t, err)
+		require.Equal(t, head, res.Head)
+
+		payload, err := json.Marshal(res)
+		require.NoError(t, err)
+
+		err = VerifyResponse(payload, head)
+		require.NoError(t, err)
+	})
+	t.Run("formatting of the response is ignored", func(t *testing.T) {
+
+		res := sampleRes
+		priv, _ := newKey(t)
+
+		err := res.Sign(priv)
+		require.NoError(t, err)
+
+		payload, err := json.MarshalIndent(res, "", "  ")
+		require.NoError(t, err)
+
+		err = VerifyResponse(payload, res.Head)
+		require.NoError(t, err)
+	})
+	t.Run("unsigned response verification fails", func(t *testing.T) {
+
+		priv, _ := newKey(t)
+		head, err := peer.IDFromPrivateKey(priv)
+		require.NoError(t, err)
+
+		payload, err := json.Marshal(sampleRes)
+		require.NoError(t, err)
+
+		err = VerifyResponse(payload, head)
+		require.Error(t, err)
+	})
+	t.Run("response signed by a different head fails", func(t *testing.T) {
+
+		res := sampleRes
+		priv, _ := newKey(t)
+		other, _ := newKey(t)
+
+		err := res.Sign(priv)
+		require.NoError(t, err)
+
+		head, err := peer.IDFromPrivateKey(other)
+		require.NoError(t, err)
+
+		payload, err := json.Marshal(res)
+		require.NoError(t, err)
+
+		err = VerifyResponse(payload, head)
+		require.Error(t, err)
+	})
+	t.Run("tampered data signature verification fails", func(t *testing.T) {
+
+		res := sampleRes
+		priv, pub := newKey(t)
+
+		err := res.Sign(priv)
+		require.NoError(t, err)
+
+		res.Results = json.RawMessage(`[{"result":{"stdout":"tampered-execution-result","stderr":"","exit_code":0},"frequency":100}]`)
+
+		err = res.VerifySignature(pub)
+		require.Error(t, err)
+	})
+}
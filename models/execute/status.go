@@ -0,0 +1,26 @@
+package execute
+
+// Status describes the stage an execution request is in.
+type Status string
+
+const (
+	StatusQueued         Status = "queued"
+	StatusRollCall       Status = "roll_call"
+	StatusFormingCluster Status = "forming_cluster"
+	StatusExecuting      Status = "executing"
+	StatusDone           Status = "done"
+	StatusFailed         Status = "failed"
+	StatusCancelled      Status = "cancelled"
+	StatusOrphaned       Status = "orphaned" // Head node stopped before the execution completed.
+)
+
+// Final returns true if the execution request will not progress any further.
+func (s Status) Final() bool {
+
+	switch s {
+	case StatusDone, StatusFailed, StatusCancelled, StatusOrphaned:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,40 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/response"
+)
+
+var _ (json.Marshaler) = (*CancelExecution)(nil)
+
+// CancelExecution describes the `MessageCancelExecution` request payload.
+// It is sent by the head node to workers executing a request that should be stopped.
+type CancelExecution struct {
+	bls.BaseMessage
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func (c CancelExecution) Response(code codes.Code) *response.CancelExecution {
+	return &response.CancelExecution{
+		BaseMessage: bls.BaseMessage{TraceInfo: c.TraceInfo},
+		RequestID:   c.RequestID,
+		Code:        code,
+	}
+}
+
+func (CancelExecution) Type() string { return bls.MessageCancelExecution }
+
+func (c CancelExecution) MarshalJSON() ([]byte, error) {
+	type Alias CancelExecution
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(c),
+		Type:  c.Type(),
+	}
+	return json.Marshal(rec)
+}
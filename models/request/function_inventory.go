@@ -0,0 +1,41 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/response"
+)
+
+var _ (json.Marshaler) = (*FunctionInventory)(nil)
+
+// FunctionInventory describes the `MessageFunctionInventory` request payload.
+// It is published by the head node to learn which functions the workers on a topic have installed.
+type FunctionInventory struct {
+	bls.BaseMessage
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func (f FunctionInventory) Response(code codes.Code, functions []bls.InstalledFunction) *response.FunctionInventory {
+	return &response.FunctionInventory{
+		BaseMessage: bls.BaseMessage{TraceInfo: f.TraceInfo},
+		RequestID:   f.RequestID,
+		Code:        code,
+		Functions:   functions,
+	}
+}
+
+func (FunctionInventory) Type() string { return bls.MessageFunctionInventory }
+
+func (f FunctionInventory) MarshalJSON() ([]byte, error) {
+	type Alias FunctionInventory
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(f),
+		Type:  f.Type(),
+	}
+	return json.Marshal(rec)
+}
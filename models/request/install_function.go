@@ -14,15 +14,17 @@ var _ (json.Marshaler) = (*InstallFunction)(nil)
 // InstallFunction describes the `MessageInstallFunction` request payload.
 type InstallFunction struct {
 	bls.BaseMessage
+	RequestID   string `json:"request_id,omitempty"`
 	ManifestURL string `json:"manifest_url,omitempty"`
 	CID         string `json:"cid,omitempty"`
 }
 
-func (f InstallFunction) Response(c codes.Code) *response.InstallFunction {
+func (f InstallFunction) Response(c codes.Code, message string) *response.InstallFunction {
 	return &response.InstallFunction{
 		BaseMessage: bls.BaseMessage{TraceInfo: f.TraceInfo},
+		RequestID:   f.RequestID,
 		Code:        c,
-		Message:     "installed",
+		Message:     message,
 		CID:         f.CID,
 	}
 }
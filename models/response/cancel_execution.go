@@ -0,0 +1,31 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+)
+
+var _ (json.Marshaler) = (*CancelExecution)(nil)
+
+// CancelExecution describes the `MessageCancelExecutionResponse` response.
+type CancelExecution struct {
+	bls.BaseMessage
+	RequestID string     `json:"request_id,omitempty"`
+	Code      codes.Code `json:"code,omitempty"`
+}
+
+func (CancelExecution) Type() string { return bls.MessageCancelExecutionResponse }
+
+func (c CancelExecution) MarshalJSON() ([]byte, error) {
+	type Alias CancelExecution
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(c),
+		Type:  c.Type(),
+	}
+	return json.Marshal(rec)
+}
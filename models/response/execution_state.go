@@ -0,0 +1,30 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+var _ (json.Marshaler) = (*ExecutionState)(nil)
+
+// ExecutionState describes the `MessageExecutionState` message payload.
+// It is published by head nodes to share the state of execution requests with other head nodes.
+type ExecutionState struct {
+	bls.BaseMessage
+	Record bls.ExecutionRecord `json:"record"`
+}
+
+func (ExecutionState) Type() string { return bls.MessageExecutionState }
+
+func (e ExecutionState) MarshalJSON() ([]byte, error) {
+	type Alias ExecutionState
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(e),
+		Type:  e.Type(),
+	}
+	return json.Marshal(rec)
+}
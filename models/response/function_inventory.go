@@ -0,0 +1,32 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+)
+
+var _ (json.Marshaler) = (*FunctionInventory)(nil)
+
+// FunctionInventory describes the `MessageFunctionInventoryResponse` response, listing the functions installed on the worker node.
+type FunctionInventory struct {
+	bls.BaseMessage
+	RequestID string                  `json:"request_id,omitempty"`
+	Code      codes.Code              `json:"code,omitempty"`
+	Functions []bls.InstalledFunction `json:"functions,omitempty"`
+}
+
+func (FunctionInventory) Type() string { return bls.MessageFunctionInventoryResponse }
+
+func (f FunctionInventory) MarshalJSON() ([]byte, error) {
+	type Alias FunctionInventory
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(f),
+		Type:  f.Type(),
+	}
+	return json.Marshal(rec)
+}
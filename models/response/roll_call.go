@@ -5,6 +5,7 @@ import (
 
 	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
 )
 
 var _ (json.Marshaler) = (*RollCall)(nil)
@@ -15,6 +16,14 @@ type RollCall struct {
 	Code       codes.Code `json:"code,omitempty"`
 	FunctionID string     `json:"function_id,omitempty"`
 	RequestID  string     `json:"request_id,omitempty"`
+
+	// Capacity of the node at the time of the response.
+	Capacity *execute.Capacity `json:"capacity,omitempty"`
+}
+
+func (r *RollCall) WithCapacity(c execute.Capacity) *RollCall {
+	r.Capacity = &c
+	return r
 }
 
 func (RollCall) Type() string { return bls.MessageRollCallResponse }
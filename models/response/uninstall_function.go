@@ -0,0 +1,33 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+)
+
+var _ (json.Marshaler) = (*UninstallFunction)(nil)
+
+// UninstallFunction describes the response to the `MessageUninstallFunction` message.
+type UninstallFunction struct {
+	bls.BaseMessage
+	RequestID string     `json:"request_id,omitempty"`
+	Code      codes.Code `json:"code,omitempty"`
+	Message   string     `json:"message,omitempty"`
+	CID       string     `json:"cid,omitempty"`
+}
+
+func (UninstallFunction) Type() string { return bls.MessageUninstallFunctionResponse }
+
+func (f UninstallFunction) MarshalJSON() ([]byte, error) {
+	type Alias UninstallFunction
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(f),
+		Type:  f.Type(),
+	}
+	return json.Marshal(rec)
+}
@@ -18,7 +18,7 @@ type WorkOrder struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 }
 
-func (w *WorkOrder) WithMetadata(m any) *WorkOrder {
+func (w *WorkOrder) WithMetadata(m json.RawMessage) *WorkOrder {
 	w.Result.Metadata = m
 	return w
 }
@@ -2,37 +2,96 @@ package aggregate
 
 import (
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/Maelkum/b7s/models/execute"
 )
 
-func Aggregate(results execute.ResultMap) Results {
+// Aggregate groups the execution results according to the requested aggregation strategy, most frequent result first.
+// Results are matched exactly unless a different strategy is requested. Results are grouped by the parts of the output
+// that the strategy compares, and each aggregated result holds the full output of one of the peers in the group.
+func Aggregate(results execute.ResultMap, cfg execute.ResultAggregation) Results {
 
-	total := len(results)
-	if total == 0 {
+	if len(results) == 0 {
 		return nil
 	}
 
+	strategy := cfg.Strategy()
+	switch strategy {
+	case execute.AggregationMedian, execute.AggregationMean:
+		return aggregateNumeric(results, strategy)
+
+	default:
+		return group(results, normalizer(strategy, cfg.Field()))
+	}
+}
+
+// normalizer returns the function that reduces the output to the parts compared by the aggregation strategy.
+func normalizer(strategy string, field string) func(execute.RuntimeOutput) execute.RuntimeOutput {
+
+	switch strategy {
+	case execute.AggregationStdout:
+		return func(output execute.RuntimeOutput) execute.RuntimeOutput {
+			return execute.RuntimeOutput{Stdout: output.Stdout, ExitCode: output.ExitCode}
+		}
+
+	case execute.AggregationJSON:
+		return func(output execute.RuntimeOutput) execute.RuntimeOutput {
+			// Outputs that are not valid JSON are compared as they are.
+			stdout, ok := canonicalJSON(output.Stdout)
+			if !ok {
+				stdout = output.Stdout
+			}
+			return execute.RuntimeOutput{Stdout: stdout, ExitCode: output.ExitCode}
+		}
+
+	case execute.AggregationJSONField:
+		return func(output execute.RuntimeOutput) execute.RuntimeOutput {
+			// Outputs without the selected field are compared as they are.
+			stdout, ok := jsonField(output.Stdout, field)
+			if !ok {
+				stdout = output.Stdout
+			}
+			return execute.RuntimeOutput{Stdout: stdout, ExitCode: output.ExitCode}
+		}
+
+	default:
+		return func(output execute.RuntimeOutput) execute.RuntimeOutput {
+			return output
+		}
+	}
+}
+
+// group groups results whose normalized outputs are identical. Normalized output is used only to group the results -
+// each group is represented by the original output of the peer with the lowest ID, so the output doesn't depend on map ordering.
+func group(results execute.ResultMap, normalize func(execute.RuntimeOutput) execute.RuntimeOutput) Results {
+
+	total := len(results)
+
 	type resultStats struct {
-		seen     uint
-		peers    []peer.ID
-		metadata map[peer.ID]any
+		seen           uint
+		peers          []peer.ID
+		metadata       map[peer.ID]any
+		representative peer.ID
+		output         execute.RuntimeOutput
 	}
 
 	stats := make(map[execute.RuntimeOutput]resultStats)
 	for executingPeer, res := range results {
 
-		// NOTE: It might make sense to ignore stderr in comparison.
-		output := res.Result.Result
+		key := normalize(res.Result.Result)
 
-		stat, ok := stats[output]
+		stat, ok := stats[key]
 		if !ok {
 			stat = resultStats{
-				seen:     0,
-				peers:    make([]peer.ID, 0),
-				metadata: make(map[peer.ID]any),
+				seen:           0,
+				peers:          make([]peer.ID, 0),
+				metadata:       make(map[peer.ID]any),
+				representative: executingPeer,
+				output:         res.Result.Result,
 			}
 		}
 
@@ -42,15 +101,20 @@ func Aggregate(results execute.ResultMap) Results {
 			stat.metadata[executingPeer] = res.Metadata
 		}
 
-		stats[output] = stat
+		if executingPeer < stat.representative {
+			stat.representative = executingPeer
+			stat.output = res.Result.Result
+		}
+
+		stats[key] = stat
 	}
 
 	// Convert map of results to a slice.
 	aggregated := make([]Result, 0, len(stats))
-	for res, stat := range stats {
+	for _, stat := range stats {
 
 		aggr := Result{
-			Result:    res,
+			Result:    stat.output,
 			Peers:     stat.peers,
 			Frequency: 100 * float64(stat.seen) / float64(total),
 			Metadata:  stat.metadata,
@@ -59,10 +123,70 @@ func Aggregate(results execute.ResultMap) Results {
 		aggregated = append(aggregated, aggr)
 	}
 
-	// Sort the slice, most frequent result first.
-	sort.SliceStable(aggregated, func(i, j int) bool {
-		return aggregated[i].Frequency > aggregated[j].Frequency
-	})
+	sortResults(aggregated)
 
 	return aggregated
 }
+
+// aggregateNumeric combines successful numeric outputs into their median or mean.
+// Remaining results are grouped by their standard output.
+func aggregateNumeric(results execute.ResultMap, strategy string) Results {
+
+	var (
+		numeric  = make(execute.ResultMap)
+		other    = make(execute.ResultMap)
+		values   = make([]float64, 0, len(results))
+		peers    = make([]peer.ID, 0, len(results))
+		metadata = make(NodeMetadata)
+	)
+
+	for executingPeer, res := range results {
+
+		output := res.Result.Result
+		value, err := strconv.ParseFloat(strings.TrimSpace(output.Stdout), 64)
+		if err != nil || output.ExitCode != 0 {
+			other[executingPeer] = res
+			continue
+		}
+
+		numeric[executingPeer] = res
+		values = append(values, value)
+		peers = append(peers, executingPeer)
+		if res.Metadata != nil {
+			metadata[executingPeer] = res.Metadata
+		}
+	}
+
+	aggregated := make([]Result, 0)
+	if len(numeric) > 0 {
+
+		value := mean(values)
+		if strategy == execute.AggregationMedian {
+			value = median(values)
+		}
+
+		aggregated = append(aggregated, Result{
+			Result:    execute.RuntimeOutput{Stdout: strconv.FormatFloat(value, 'f', -1, 64)},
+			Peers:     peers,
+			Frequency: 100 * float64(len(numeric)) / float64(len(results)),
+			Metadata:  metadata,
+		})
+	}
+
+	// Rescale frequencies of the remaining results so they are relative to all results.
+	for _, res := range group(other, normalizer(execute.AggregationStdout, "")) {
+		res.Frequency = res.Frequency * float64(len(other)) / float64(len(results))
+		aggregated = append(aggregated, res)
+	}
+
+	sortResults(aggregated)
+
+	return aggregated
+}
+
+// sortResults sorts the results, most frequent result first.
+func sortResults(results Results) {
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Frequency > results[j].Frequency
+	})
+}
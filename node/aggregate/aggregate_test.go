@@ -0,0 +1,152 @@
+package aggregate
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestAggregate(t *testing.T) {
+
+	peers := mocks.GenericPeerIDs[:4]
+
+	createResults := func(outputs ...execute.RuntimeOutput) execute.ResultMap {
+		results := make(execute.ResultMap)
+		for i, output := range outputs {
+			results[peers[i]] = execute.NodeResult{
+				Result: execute.Result{Result: output},
+			}
+		}
+		return results
+	}
+
+	aggregation := func(strategy string, params ...execute.Parameter) execute.ResultAggregation {
+		return execute.ResultAggregation{Enable: true, Type: strategy, Parameters: params}
+	}
+
+	t.Run("exact match by default", func(t *testing.T) {
+		t.Parallel()
+
+		results := createResults(
+			execute.RuntimeOutput{Stdout: "output", Stderr: "first"},
+			execute.RuntimeOutput{Stdout: "output", Stderr: "second"},
+			execute.RuntimeOutput{Stdout: "output", Stderr: "second"},
+		)
+
+		// Strategy is ignored if aggregation is not enabled.
+		aggregated := Aggregate(results, execute.ResultAggregation{Type: execute.AggregationStdout})
+		require.Len(t, aggregated, 2)
+		require.Equal(t, "second", aggregated[0].Result.Stderr)
+		require.ElementsMatch(t, []peer.ID{peers[1], peers[2]}, aggregated[0].Peers)
+	})
+	t.Run("stdout match ignores stderr", func(t *testing.T) {
+		t.Parallel()
+
+		results := createResults(
+			execute.RuntimeOutput{Stdout: "output", Stderr: "first"},
+			execute.RuntimeOutput{Stdout: "output", Stderr: "second"},
+			execute.RuntimeOutput{Stdout: "output", ExitCode: 1},
+		)
+
+		aggregated := Aggregate(results, aggregation(execute.AggregationStdout))
+		require.Len(t, aggregated, 2)
+		require.InDelta(t, 100*2.0/3.0, aggregated[0].Frequency, 0.001)
+
+		// Standard error is not compared, but it is still returned - output of the peer with the lowest ID represents the group.
+		require.Equal(t, results[min(peers[0], peers[1])].Result.Result, aggregated[0].Result)
+		require.NotEmpty(t, aggregated[0].Result.Stderr)
+	})
+	t.Run("json match ignores formatting", func(t *testing.T) {
+		t.Parallel()
+
+		results := createResults(
+			execute.RuntimeOutput{Stdout: `{"a": 1, "b": [1, 2]}`},
+			execute.RuntimeOutput{Stdout: `{"b":[1,2],"a":1}`},
+			execute.RuntimeOutput{Stdout: "{\n  \"a\": 1,\n  \"b\": [1, 2]\n}\n"},
+			execute.RuntimeOutput{Stdout: "not json"},
+		)
+
+		aggregated := Aggregate(results, aggregation(execute.AggregationJSON))
+		require.Len(t, aggregated, 2)
+		require.Contains(t, []string{results[peers[0]].Result.Result.Stdout, results[peers[1]].Result.Result.Stdout, results[peers[2]].Result.Result.Stdout}, aggregated[0].Result.Stdout)
+		require.Len(t, aggregated[0].Peers, 3)
+		require.Equal(t, "not json", aggregated[1].Result.Stdout)
+	})
+	t.Run("json match distinguishes large integers", func(t *testing.T) {
+		t.Parallel()
+
+		// Both values are the same when converted to float64.
+		results := createResults(
+			execute.RuntimeOutput{Stdout: `{"id": 9007199254740993}`},
+			execute.RuntimeOutput{Stdout: `{"id": 9007199254740992}`},
+		)
+
+		aggregated := Aggregate(results, aggregation(execute.AggregationJSON))
+		require.Len(t, aggregated, 2)
+		require.ElementsMatch(t,
+			[]string{`{"id": 9007199254740993}`, `{"id": 9007199254740992}`},
+			[]string{aggregated[0].Result.Stdout, aggregated[1].Result.Stdout},
+		)
+
+		aggregated = Aggregate(results, aggregation(execute.AggregationJSONField, execute.Parameter{Name: execute.AggregationFieldParameter, Value: "id"}))
+		require.Len(t, aggregated, 2)
+	})
+	t.Run("json field match", func(t *testing.T) {
+		t.Parallel()
+
+		results := createResults(
+			execute.RuntimeOutput{Stdout: `{"data": {"price": 10}, "timestamp": 1}`},
+			execute.RuntimeOutput{Stdout: `{"data": {"price": 10}, "timestamp": 2}`},
+			execute.RuntimeOutput{Stdout: `{"data": {"price": 11}, "timestamp": 3}`},
+			execute.RuntimeOutput{Stdout: `{"data": {}}`},
+		)
+
+		aggregated := Aggregate(results, aggregation(execute.AggregationJSONField, execute.Parameter{Name: execute.AggregationFieldParameter, Value: "data.price"}))
+		require.Len(t, aggregated, 3)
+		require.Contains(t, []string{results[peers[0]].Result.Result.Stdout, results[peers[1]].Result.Result.Stdout}, aggregated[0].Result.Stdout)
+		require.ElementsMatch(t, []peer.ID{peers[0], peers[1]}, aggregated[0].Peers)
+		require.Equal(t, float64(50), aggregated[0].Frequency)
+	})
+	t.Run("numeric median", func(t *testing.T) {
+		t.Parallel()
+
+		results := createResults(
+			execute.RuntimeOutput{Stdout: "1"},
+			execute.RuntimeOutput{Stdout: "2.5\n"},
+			execute.RuntimeOutput{Stdout: "10"},
+			execute.RuntimeOutput{Stdout: "not a number"},
+		)
+
+		aggregated := Aggregate(results, aggregation(execute.AggregationMedian))
+		require.Len(t, aggregated, 2)
+		require.Equal(t, "2.5", aggregated[0].Result.Stdout)
+		require.Len(t, aggregated[0].Peers, 3)
+		require.Equal(t, float64(75), aggregated[0].Frequency)
+		require.Equal(t, "not a number", aggregated[1].Result.Stdout)
+		require.Equal(t, float64(25), aggregated[1].Frequency)
+	})
+	t.Run("numeric mean", func(t *testing.T) {
+		t.Parallel()
+
+		results := createResults(
+			execute.RuntimeOutput{Stdout: "1"},
+			execute.RuntimeOutput{Stdout: "2"},
+			execute.RuntimeOutput{Stdout: "6"},
+			execute.RuntimeOutput{Stdout: "100", ExitCode: 1},
+		)
+
+		aggregated := Aggregate(results, aggregation(execute.AggregationMean))
+		require.Len(t, aggregated, 2)
+		require.Equal(t, "3", aggregated[0].Result.Stdout)
+		require.Equal(t, execute.RuntimeOutput{Stdout: "100", ExitCode: 1}, aggregated[1].Result)
+	})
+	t.Run("no results", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, Aggregate(nil, aggregation(execute.AggregationMean)))
+	})
+}
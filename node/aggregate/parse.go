@@ -0,0 +1,99 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+)
+
+// canonicalJSON returns the JSON value in a canonical form - without insignificant whitespace and with sorted object keys.
+func canonicalJSON(data string) (string, bool) {
+
+	value, err := decodeJSON(data)
+	if err != nil {
+		return "", false
+	}
+
+	return marshalCanonical(value)
+}
+
+// jsonField returns the value of the field in canonical JSON form. Nested fields are selected using a dot-separated path.
+func jsonField(data string, path string) (string, bool) {
+
+	value, err := decodeJSON(data)
+	if err != nil {
+		return "", false
+	}
+
+	for _, name := range strings.Split(path, ".") {
+
+		object, ok := value.(map[string]any)
+		if !ok {
+			return "", false
+		}
+
+		value, ok = object[name]
+		if !ok {
+			return "", false
+		}
+	}
+
+	return marshalCanonical(value)
+}
+
+// decodeJSON decodes the JSON value. Numbers are kept as they are written, since converting them to float64 loses precision for large integers.
+func decodeJSON(data string) (any, error) {
+
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+
+	var value any
+	err := dec.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Input should contain a single JSON value.
+	err = dec.Decode(new(json.RawMessage))
+	if !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+
+	return value, nil
+}
+
+func marshalCanonical(value any) (string, bool) {
+
+	// Maps are marshalled with sorted keys.
+	out, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+
+	return string(out), true
+}
+
+func mean(values []float64) float64 {
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values))
+}
+
+func median(values []float64) float64 {
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
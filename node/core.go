@@ -2,6 +2,7 @@ package node
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/armon/go-metrics"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -30,6 +31,7 @@ type Logger interface {
 type Network interface {
 	Host() *host.Host
 	Connected(peer.ID) bool
+	TopicPeers(string) []peer.ID
 	Messaging
 }
 
@@ -50,6 +52,7 @@ type Telemetry interface {
 
 type NodeOps interface {
 	Run(context.Context, func(context.Context, peer.ID, string, []byte) error) error
+	Load() bls.Load
 }
 
 type core struct {
@@ -60,6 +63,10 @@ type core struct {
 
 	topics *syncmap.Map[string, topicInfo]
 
+	// Limits the number of published messages processed in parallel.
+	sema   chan struct{}
+	queued atomic.Int64
+
 	// Telemetry
 	tracer  *tracing.Tracer
 	metrics *metrics.Metrics
@@ -79,6 +86,7 @@ func NewCore(log zerolog.Logger, host *host.Host, opts ...Option) *core {
 		tracer:  tracing.NewTracer(tracerName),
 		metrics: metrics.Default(),
 		topics:  syncmap.New[string, topicInfo](),
+		sema:    make(chan struct{}, cfg.Concurrency),
 	}
 
 	return core
@@ -104,6 +112,18 @@ func (c *core) Metrics() *metrics.Metrics {
 	return c.metrics
 }
 
+// Load returns how busy the node is processing published messages.
+func (c *core) Load() bls.Load {
+
+	load := bls.Load{
+		Active: uint(len(c.sema)),
+		Limit:  uint(cap(c.sema)),
+		Queued: uint(max(c.queued.Load(), 0)),
+	}
+
+	return load
+}
+
 func (c *core) Network() {
 	c.host.Network()
 }
@@ -0,0 +1,109 @@
+package head
+
+import (
+	"context"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+// admission limits the number of executions the head node runs at the same time.
+// Requests over the limit wait in a bounded queue. Once the queue is full, requests are rejected.
+type admission struct {
+	metrics *metrics.Metrics
+
+	slots chan struct{} // Execution slots. Nil if the number of concurrent executions is not limited.
+	queue chan struct{} // Requests waiting for an execution slot.
+}
+
+func newAdmission(metrics *metrics.Metrics, limit uint, queueSize uint) *admission {
+
+	a := admission{
+		metrics: metrics,
+	}
+
+	if limit > 0 {
+		a.slots = make(chan struct{}, limit)
+		a.queue = make(chan struct{}, queueSize)
+	}
+
+	return &a
+}
+
+// reserve admits an execution request. If there are no free execution slots, the request takes a place in the queue.
+// If the queue is full, the request is rejected.
+func (a *admission) reserve() (*ticket, error) {
+
+	t := &ticket{admission: a}
+
+	// No limit on concurrent executions.
+	if a.slots == nil {
+		return t, nil
+	}
+
+	// Take a free execution slot, if there is one.
+	select {
+	case a.slots <- struct{}{}:
+		t.acquired = true
+		return t, nil
+	default:
+	}
+
+	select {
+	case a.queue <- struct{}{}:
+		t.queued = true
+		a.reportQueueDepth()
+		return t, nil
+
+	default:
+		a.metrics.IncrCounterWithLabels(executionsRejectedMetric, 1, []metrics.Label{{Name: "reason", Value: "queue_full"}})
+		return nil, bls.ErrTooManyRequests
+	}
+}
+
+func (a *admission) reportQueueDepth() {
+	a.metrics.SetGauge(executionQueueMetric, float32(len(a.queue)))
+}
+
+// ticket represents an admitted execution request.
+type ticket struct {
+	admission *admission
+
+	queued   bool // Request is waiting in the queue.
+	acquired bool // Request holds an execution slot.
+}
+
+// wait blocks until the request gets an execution slot. The request leaves the queue either way.
+func (t *ticket) wait(ctx context.Context) error {
+
+	if !t.queued {
+		return nil
+	}
+
+	defer func() {
+		<-t.admission.queue
+		t.queued = false
+		t.admission.reportQueueDepth()
+	}()
+
+	select {
+	case t.admission.slots <- struct{}{}:
+		t.acquired = true
+		return nil
+
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// release frees the execution slot held by the request.
+func (t *ticket) release() {
+
+	if !t.acquired {
+		return
+	}
+
+	<-t.admission.slots
+	t.acquired = false
+}
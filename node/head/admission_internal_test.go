@@ -0,0 +1,104 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_Admission(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		t.Parallel()
+
+		admission := newAdmission(metrics.Default(), 0, 0)
+
+		for range 10 {
+			ticket, err := admission.reserve()
+			require.NoError(t, err)
+			require.False(t, ticket.queued)
+			require.NoError(t, ticket.wait(context.Background()))
+		}
+	})
+	t.Run("requests over the limit are queued", func(t *testing.T) {
+		t.Parallel()
+
+		admission := newAdmission(metrics.Default(), 1, 1)
+
+		running, err := admission.reserve()
+		require.NoError(t, err)
+		require.False(t, running.queued)
+
+		waiting, err := admission.reserve()
+		require.NoError(t, err)
+		require.True(t, waiting.queued)
+
+		// Queue is full.
+		_, err = admission.reserve()
+		require.ErrorIs(t, err, bls.ErrTooManyRequests)
+
+		admitted := make(chan error)
+		go func() {
+			admitted <- waiting.wait(context.Background())
+		}()
+
+		select {
+		case <-admitted:
+			require.FailNow(t, "request admitted while execution slot is taken")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		running.release()
+		require.NoError(t, <-admitted)
+		require.Len(t, admission.queue, 0)
+
+		// Request left the queue so there's room for another one.
+		queued, err := admission.reserve()
+		require.NoError(t, err)
+		require.True(t, queued.queued)
+	})
+	t.Run("waiting request is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		admission := newAdmission(metrics.Default(), 1, 1)
+
+		_, err := admission.reserve()
+		require.NoError(t, err)
+
+		waiting, err := admission.reserve()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(bls.ErrExecutionCancelled)
+
+		err = waiting.wait(ctx)
+		require.ErrorIs(t, err, bls.ErrExecutionCancelled)
+		require.Len(t, admission.queue, 0)
+	})
+}
+
+func TestHead_ExecuteFunction_QueueFull(t *testing.T) {
+
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t),
+		MaxConcurrentExecutions(1),
+		ExecutionQueueSize(0),
+	)
+	require.NoError(t, err)
+
+	// Take the only execution slot.
+	_, err = head.admission.reserve()
+	require.NoError(t, err)
+
+	code, _, _, _, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+	require.ErrorIs(t, err, bls.ErrTooManyRequests)
+	require.Equal(t, codes.TooManyRequests, code)
+
+	_, err = head.ExecuteFunctionAsync(context.Background(), mocks.GenericExecutionRequest, "")
+	require.ErrorIs(t, err, bls.ErrTooManyRequests)
+}
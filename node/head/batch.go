@@ -0,0 +1,375 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+)
+
+// ExecuteBatch runs all batch items using a single roll call, spreading the executions over the peers that reported.
+// Failure of individual items does not fail the batch. Returned results are ordered as the batch items.
+func (h *HeadNode) ExecuteBatch(ctx context.Context, batch execute.Batch, subgroup string) (codes.Code, string, []execute.BatchItemResult, execute.Cluster, error) {
+
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		return codes.TooManyRequests, "", nil, execute.Cluster{}, err
+	}
+
+	batchID := newRequestID()
+
+	record, err := h.executeBatchAndSave(ctx, batchID, batch, subgroup, ticket)
+	if err != nil {
+		h.Log().Error().Str("request", batchID).Err(err).Msg("batch execution failed")
+	}
+
+	return record.Code, batchID, record.Batch, record.Cluster, nil
+}
+
+// ExecuteBatchAsync starts batch execution in the background and returns the batch request ID immediately.
+// Batch progress and results can be retrieved using the request ID.
+func (h *HeadNode) ExecuteBatchAsync(ctx context.Context, batch execute.Batch, subgroup string) (string, error) {
+
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		return "", err
+	}
+
+	batchID := newRequestID()
+
+	// Set status now so the request is known even before the execution starts.
+	status := execute.StatusRollCall
+	if ticket.queued {
+		status = execute.StatusQueued
+	}
+	h.setStatus(batchID, status)
+
+	// Execution should outlive the API request that started it.
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		_, err := h.executeBatchAndSave(ctx, batchID, batch, subgroup, ticket)
+		if err != nil {
+			h.Log().Error().Str("request", batchID).Err(err).Msg("batch execution failed")
+		}
+	}()
+
+	return batchID, nil
+}
+
+func (h *HeadNode) executeBatchAndSave(ctx context.Context, batchID string, batch execute.Batch, subgroup string, ticket *ticket) (bls.ExecutionRecord, error) {
+
+	progress := newBatchProgress(len(batch.Items))
+
+	h.batches.Set(batchID, progress)
+	defer h.batches.Delete(batchID)
+
+	return h.runAndSave(ctx, batchID, batch.Request, ticket, func(ctx context.Context, record *bls.ExecutionRecord) error {
+		var err error
+		record.Code, record.Cluster, err = h.executeBatch(ctx, batchID, batch, subgroup, progress)
+		record.Batch = progress.results()
+		return err
+	})
+}
+
+// executeBatch issues a roll call for the batch and distributes batch items over the peers that reported.
+func (h *HeadNode) executeBatch(ctx context.Context, batchID string, batch execute.Batch, subgroup string, progress *batchProgress) (codes.Code, execute.Cluster, error) {
+
+	h.Metrics().IncrCounterWithLabels(executionsMetric, float32(len(batch.Items)),
+		[]metrics.Label{
+			{Name: "function", Value: batch.FunctionID},
+			{Name: "consensus", Value: batch.Config.ConsensusAlgorithm},
+		})
+
+	log := h.Log().With().
+		Str("request", batchID).
+		Str("function", batch.FunctionID).
+		Int("items", len(batch.Items)).
+		Logger()
+
+	log.Info().Msg("processing batch execution request")
+
+	req := request.Execute{
+		Request: batch.Request,
+		Topic:   subgroup,
+	}
+
+	// Take any peers that report, unless the request asks for a specific number. We don't need more peers than there are items.
+	if req.Config.NodeCount <= 0 {
+		req.Config.NodeCount = -1
+	} else {
+		req.Config.NodeCount = min(req.Config.NodeCount, len(batch.Items))
+	}
+
+	h.setStatus(batchID, execute.StatusRollCall)
+	cluster, err := h.executeRollCall(ctx, batchID, req, 0, nil)
+	if err != nil {
+		code := codes.Error
+		if errors.Is(err, bls.ErrRollCallTimeout) {
+			code = codes.Timeout
+		}
+
+		return code, execute.Cluster{}, fmt.Errorf("could not roll call peers (request: %s): %w", batchID, err)
+	}
+
+	h.updateRequest(batchID, func(state *requestState) {
+		state.peers = cluster.Peers
+	})
+
+	h.load.add(cluster.Peers)
+	defer h.load.done(cluster.Peers)
+
+	h.setStatus(batchID, execute.StatusExecuting)
+
+	log.Info().Strs("peers", bls.PeerIDsToStr(cluster.Peers)).Msg("distributing batch items to peers")
+
+	// Peers take items from a shared queue, so faster peers get more work.
+	// Items that fail are put back in the queue so they can be picked up by another peer.
+	queue := make(chan batchItem, len(batch.Items))
+	for i := range batch.Items {
+		queue <- batchItem{index: i}
+	}
+
+	// Close the queue once all items are either executed or out of attempts.
+	var pending sync.WaitGroup
+	pending.Add(len(batch.Items))
+	go func() {
+		pending.Wait()
+		close(queue)
+	}()
+
+	var wg sync.WaitGroup
+	for _, worker := range cluster.Peers {
+
+		// Work on as many items at once as the peer has free execution slots.
+		slots := uint(1)
+		capacity, ok := cluster.Capacity[worker]
+		if ok && capacity.FreeSlots > 0 {
+			slots = capacity.FreeSlots
+		}
+
+		// Consecutive failures of this peer. Peers that keep failing stop taking items, leaving them to the other peers.
+		var failures atomic.Int64
+
+		for range slots {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for item := range queue {
+
+					// Batch was cancelled - leave remaining items unexecuted.
+					if ctx.Err() != nil {
+						pending.Done()
+						continue
+					}
+
+					// Peer gave up in the meantime - return the item to the queue.
+					if failures.Load() >= batchPeerMaxFailures {
+						queue <- item
+						return
+					}
+
+					// Each attempt gets its own request ID, so that results of earlier attempts are not mistaken for the current one.
+					requestID := progress.requestID(item.index)
+					if item.attempts > 0 {
+						requestID = progress.newAttempt(item.index)
+					}
+
+					wo := request.Execute{Request: batch.Item(item.index), Topic: subgroup}
+					h.setBatchItemPeer(batchID, requestID, worker)
+					result := h.executeBatchItem(ctx, requestID, wo, worker)
+					h.setBatchItemPeer(batchID, requestID, "")
+					progress.set(item.index, result)
+
+					if result.Code == codes.OK {
+						failures.Store(0)
+						pending.Done()
+						continue
+					}
+
+					item.attempts++
+					if item.attempts < batchItemMaxAttempts && ctx.Err() == nil {
+						queue <- item
+					} else {
+						pending.Done()
+					}
+
+					if failures.Add(1) >= batchPeerMaxFailures {
+						log.Warn().Stringer("peer", worker).Msg("peer keeps failing batch items, not sending it any more items")
+						return
+					}
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	// All peers gave up - report items that remained in the queue as failed.
+	for remaining := true; remaining; {
+		select {
+		case item, ok := <-queue:
+			if !ok {
+				remaining = false
+				break
+			}
+
+			if item.attempts == 0 {
+				progress.set(item.index, execute.BatchItemResult{
+					RequestID: progress.requestID(item.index),
+					Code:      codes.Error,
+					Message:   "no peer available to execute the item",
+				})
+			}
+			pending.Done()
+
+		default:
+			remaining = false
+		}
+	}
+
+	succeeded := progress.succeeded()
+
+	log.Info().Int("succeeded", succeeded).Msg("batch execution complete")
+
+	// Failure of individual items does not fail the batch.
+	switch succeeded {
+	case len(batch.Items):
+		return codes.OK, cluster, nil
+	case 0:
+		return codes.NoContent, cluster, nil
+	default:
+		return codes.PartialContent, cluster, nil
+	}
+}
+
+// executeBatchItem sends the work order for a single batch item to the peer and waits for the result.
+func (h *HeadNode) executeBatchItem(ctx context.Context, requestID string, req request.Execute, worker peer.ID) execute.BatchItemResult {
+
+	result := execute.BatchItemResult{
+		RequestID: requestID,
+		Peer:      worker,
+	}
+
+	err := h.Send(ctx, worker, req.WorkOrder(requestID))
+	if err != nil {
+		h.Log().Warn().Err(err).Str("request", requestID).Stringer("peer", worker).Msg("could not send batch item work order")
+
+		result.Code = codes.Error
+		result.Message = "could not send work order"
+		return result
+	}
+
+	results := h.gatherExecutionResults(ctx, requestID, []peer.ID{worker})
+	res, ok := results[worker]
+	if !ok {
+		result.Code = codes.Timeout
+		result.Message = bls.ErrExecutionNotEnoughNodes.Error()
+		return result
+	}
+
+	result.Code = res.Code
+	result.Result = res.Result.Result
+
+	return result
+}
+
+// setBatchItemPeer records the peer executing the batch item, so it can be asked to stop if the batch is cancelled.
+// Empty peer ID means the item is no longer in progress.
+func (h *HeadNode) setBatchItemPeer(batchID string, requestID string, worker peer.ID) {
+
+	h.updateRequest(batchID, func(state *requestState) {
+
+		// Items are replaced instead of modified, since the request state is read without holding the lock.
+		items := maps.Clone(state.items)
+		if items == nil {
+			items = make(map[string]peer.ID)
+		}
+
+		if worker == "" {
+			delete(items, requestID)
+		} else {
+			items[requestID] = worker
+		}
+
+		state.items = items
+	})
+}
+
+// batchItem is a batch item waiting for execution.
+type batchItem struct {
+	index    int // Index of the item in the batch.
+	attempts int // Number of times the item was tried.
+}
+
+// batchProgress tracks results of batch items as they complete.
+type batchProgress struct {
+	sync.Mutex
+	items []execute.BatchItemResult
+}
+
+func newBatchProgress(count int) *batchProgress {
+
+	items := make([]execute.BatchItemResult, count)
+	for i := range items {
+		items[i].RequestID = newRequestID()
+	}
+
+	return &batchProgress{items: items}
+}
+
+func (p *batchProgress) requestID(i int) string {
+	p.Lock()
+	defer p.Unlock()
+
+	return p.items[i].RequestID
+}
+
+// newAttempt assigns a new request ID to the batch item, for a new execution attempt.
+func (p *batchProgress) newAttempt(i int) string {
+	p.Lock()
+	defer p.Unlock()
+
+	p.items[i].RequestID = newRequestID()
+	return p.items[i].RequestID
+}
+
+func (p *batchProgress) set(i int, result execute.BatchItemResult) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.items[i] = result
+}
+
+func (p *batchProgress) results() []execute.BatchItemResult {
+	p.Lock()
+	defer p.Unlock()
+
+	return slices.Clone(p.items)
+}
+
+func (p *batchProgress) succeeded() int {
+	p.Lock()
+	defer p.Unlock()
+
+	n := 0
+	for _, item := range p.items {
+		if item.Code == codes.OK {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,236 @@
+package head
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/store"
+	"github.com/Maelkum/b7s/store/codec"
+	"github.com/Maelkum/b7s/testing/helpers"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_ExecuteBatch(t *testing.T) {
+
+	const failing = "fail"
+
+	workers, keys := newWorkers(t, 2)
+
+	createBatch := func(inputs ...string) execute.Batch {
+
+		batch := execute.Batch{
+			Request: mocks.GenericExecutionRequest,
+		}
+		batch.Config.NodeCount = len(workers)
+
+		for _, input := range inputs {
+			batch.Items = append(batch.Items, execute.BatchItem{Stdin: &input})
+		}
+
+		return batch
+	}
+
+	// Setup a head node with workers that echo their standard input, and record which worker executed which item.
+	setupHead := func(t *testing.T) (*HeadNode, func() map[string]peer.ID) {
+		t.Helper()
+
+		var (
+			lock     sync.Mutex
+			executed = make(map[string]peer.ID)
+		)
+
+		head := createHeadNode(t)
+		head.results = newResultStore(mocks.NoopLogger, store.New(helpers.InMemoryDB(t), codec.NewJSONCodec()), time.Hour, 100)
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			for _, worker := range workers {
+				head.rollCall.add(rc.RequestID, rollCallResponse{
+					From: worker,
+					RollCall: response.RollCall{
+						Code:       codes.Accepted,
+						FunctionID: rc.FunctionID,
+						RequestID:  rc.RequestID,
+					},
+				})
+			}
+
+			return nil
+		}
+		core.SendFunc = func(_ context.Context, to peer.ID, msg bls.Message) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			input := *wo.Request.Config.Stdin
+
+			lock.Lock()
+			executed[input] = to
+			lock.Unlock()
+
+			result := execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: input}}
+			if input == failing {
+				result.Code = codes.Error
+			}
+
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, to), signedResult(t, keys[to], wo.RequestID, execute.NodeResult{Result: result}))
+
+			return nil
+		}
+		head.Core = core
+
+		items := func() map[string]peer.ID {
+			lock.Lock()
+			defer lock.Unlock()
+
+			return executed
+		}
+
+		return head, items
+	}
+
+	t.Run("items are executed on the responding workers", func(t *testing.T) {
+		t.Parallel()
+
+		head, executed := setupHead(t)
+
+		inputs := []string{"first", "second", "third", "fourth", "fifth"}
+
+		code, id, items, cluster, err := head.ExecuteBatch(context.Background(), createBatch(inputs...), "")
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+		require.NotEmpty(t, id)
+		require.ElementsMatch(t, workers, cluster.Peers)
+
+		require.Len(t, executed(), len(inputs))
+		require.Len(t, items, len(inputs))
+
+		// Results are ordered as the batch items, and each item has its own request ID.
+		for i, item := range items {
+			require.Equal(t, codes.OK, item.Code)
+			require.Equal(t, inputs[i], item.Result.Stdout)
+			require.Equal(t, executed()[inputs[i]], item.Peer)
+			require.NotEqual(t, id, item.RequestID)
+		}
+
+		// Batch result is persisted.
+		record, ok := head.ExecutionResult(context.Background(), id)
+		require.True(t, ok)
+		require.Equal(t, execute.StatusDone, record.Status)
+		require.Equal(t, items, record.Batch)
+	})
+	t.Run("failed items do not fail the batch", func(t *testing.T) {
+		t.Parallel()
+
+		head, _ := setupHead(t)
+
+		code, _, items, _, err := head.ExecuteBatch(context.Background(), createBatch("first", failing), "")
+		require.NoError(t, err)
+		require.Equal(t, codes.PartialContent, code)
+
+		require.Len(t, items, 2)
+		require.Equal(t, codes.OK, items[0].Code)
+		require.Equal(t, codes.Error, items[1].Code)
+	})
+	t.Run("items failed by a peer are executed by another peer", func(t *testing.T) {
+		t.Parallel()
+
+		head, _ := setupHead(t)
+
+		// First worker cannot be reached, so it fails items quickly.
+		offline := workers[0]
+
+		core := head.Core.(*mocks.NodeCore)
+		send := core.SendFunc
+		core.SendFunc = func(ctx context.Context, to peer.ID, msg bls.Message) error {
+			if to == offline {
+				return mocks.GenericError
+			}
+			return send(ctx, to, msg)
+		}
+
+		inputs := []string{"first", "second", "third", "fourth", "fifth", "sixth"}
+
+		code, _, items, _, err := head.ExecuteBatch(context.Background(), createBatch(inputs...), "")
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		require.Len(t, items, len(inputs))
+		for i, item := range items {
+			require.Equal(t, codes.OK, item.Code)
+			require.Equal(t, inputs[i], item.Result.Stdout)
+			require.Equal(t, workers[1], item.Peer)
+		}
+	})
+	t.Run("cancelled batch stops items on workers", func(t *testing.T) {
+		t.Parallel()
+
+		head, _ := setupHead(t)
+
+		var (
+			lock      sync.Mutex
+			assigned  = make(map[string]peer.ID) // Work orders sent to workers, by item request ID.
+			cancelled = make(map[string]peer.ID) // Cancellation requests sent to workers, by item request ID.
+		)
+
+		// Workers never respond to work orders, so items stay in progress until cancelled.
+		core := head.Core.(*mocks.NodeCore)
+		core.SendFunc = func(_ context.Context, to peer.ID, msg bls.Message) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			lock.Lock()
+			defer lock.Unlock()
+			assigned[wo.RequestID] = to
+
+			return nil
+		}
+		core.SendToManyFunc = func(ctx context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+			req, ok := any(msg).(*request.CancelExecution)
+			require.True(t, ok)
+			require.Len(t, peers, 1)
+
+			lock.Lock()
+			cancelled[req.RequestID] = peers[0]
+			lock.Unlock()
+
+			return head.processCancelExecutionResponse(ctx, peers[0], *req.Response(codes.OK))
+		}
+
+		id, err := head.ExecuteBatchAsync(context.Background(), createBatch("first", "second"), "")
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			state, _ := head.requests.Get(id)
+			return len(state.items) == len(workers)
+		}, time.Second, 10*time.Millisecond)
+
+		peers, confirmed, err := head.CancelExecution(context.Background(), id)
+		require.NoError(t, err)
+		require.ElementsMatch(t, workers, peers)
+		require.ElementsMatch(t, workers, confirmed)
+
+		lock.Lock()
+		require.Equal(t, assigned, cancelled)
+		lock.Unlock()
+	})
+}
@@ -0,0 +1,120 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+)
+
+// CancelExecution stops an in-progress execution. Peers working on the request are asked to stop the execution.
+// Returns the list of peers that were working on the request, as well as the list of peers that confirmed cancellation.
+func (h *HeadNode) CancelExecution(ctx context.Context, id string) ([]peer.ID, []peer.ID, error) {
+
+	state, ok := h.requests.Get(id)
+	if !ok {
+
+		// We might know of the request from the state shared by the head node handling it.
+		record, found := h.results.get(ctx, id)
+		if found && !record.Status.Final() && record.Head != "" && record.Head != h.Host().ID() {
+			return nil, nil, fmt.Errorf("%w (head: %s)", bls.ErrExecutionNotOwned, record.Head.String())
+		}
+
+		return nil, nil, bls.ErrNotFound
+	}
+
+	log := h.Log().With().Str("request", id).Logger()
+
+	log.Info().Strs("peers", bls.PeerIDsToStr(state.peers)).Msg("cancelling execution")
+
+	// Stop the execution on our end.
+	if state.cancel != nil {
+		state.cancel(bls.ErrExecutionCancelled)
+	}
+
+	// If we're still doing roll call, there are no peers to notify.
+	if len(state.peers) == 0 {
+		return nil, nil, nil
+	}
+
+	// Peers know batch items by their own request IDs, so they are asked to stop the items they are working on.
+	work := map[string][]peer.ID{id: state.peers}
+	_, batch := h.batches.Get(id)
+	if batch {
+		work = make(map[string][]peer.ID, len(state.items))
+		for itemID, rp := range state.items {
+			work[itemID] = []peer.ID{rp}
+		}
+	}
+
+	for requestID, peers := range work {
+		err := h.SendToMany(ctx, peers, &request.CancelExecution{RequestID: requestID}, false)
+		if err != nil {
+			return state.peers, nil, fmt.Errorf("could not send cancellation request to peers: %w", err)
+		}
+	}
+
+	// We're willing to wait for a limited amount of time.
+	wctx, cancel := context.WithTimeout(ctx, cancelResponseTimeout)
+	defer cancel()
+
+	var (
+		confirmed []peer.ID
+		lock      sync.Mutex
+		wg        sync.WaitGroup
+	)
+
+	for requestID, peers := range work {
+
+		wg.Add(len(peers))
+		for _, rp := range peers {
+			go func() {
+				defer wg.Done()
+
+				res, ok := h.cancelResponses.WaitFor(wctx, peerRequestKey(requestID, rp))
+				if !ok {
+					return
+				}
+
+				if res.Code != codes.OK {
+					log.Warn().Stringer("peer", rp).Str("work_order", requestID).Stringer("code", res.Code).Msg("peer did not cancel execution")
+					return
+				}
+
+				lock.Lock()
+				defer lock.Unlock()
+				if !slices.Contains(confirmed, rp) {
+					confirmed = append(confirmed, rp)
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	log.Info().Strs("confirmed", bls.PeerIDsToStr(confirmed)).Msg("execution cancelled")
+
+	return state.peers, confirmed, nil
+}
+
+// processCancelExecutionResponse will record the execution cancellation response.
+func (h *HeadNode) processCancelExecutionResponse(ctx context.Context, from peer.ID, res response.CancelExecution) error {
+
+	h.Log().Debug().
+		Stringer("from", from).
+		Str("request", res.RequestID).
+		Stringer("code", res.Code).
+		Msg("received execution cancellation response")
+
+	key := peerRequestKey(res.RequestID, from)
+	h.cancelResponses.Set(key, res)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/store"
+	"github.com/Maelkum/b7s/store/codec"
+	"github.com/Maelkum/b7s/testing/helpers"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_CancelExecution(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+	)
+
+	var (
+		ctx = context.Background()
+
+		confirming = mocks.GenericPeerIDs[0]
+		refusing   = mocks.GenericPeerIDs[1]
+	)
+
+	head := createHeadNode(t)
+
+	// Peers respond to cancellation requests as soon as they receive them.
+	core := mocks.BaselineNodeCore(t)
+	core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+		req, ok := msg.(*request.CancelExecution)
+		require.True(t, ok)
+		require.Equal(t, requestID, req.RequestID)
+		require.ElementsMatch(t, []peer.ID{confirming, refusing}, peers)
+
+		err := head.processCancelExecutionResponse(ctx, confirming, *req.Response(codes.OK))
+		require.NoError(t, err)
+
+		err = head.processCancelExecutionResponse(ctx, refusing, *req.Response(codes.NotPermitted))
+		require.NoError(t, err)
+
+		return nil
+	}
+	head.Core = core
+
+	_, _, err := head.CancelExecution(ctx, requestID)
+	require.ErrorIs(t, err, bls.ErrNotFound)
+
+	var cause error
+	head.requests.Set(requestID, requestState{
+		status: execute.StatusExecuting,
+		peers:  []peer.ID{confirming, refusing},
+		cancel: func(err error) { cause = err },
+	})
+
+	peers, confirmed, err := head.CancelExecution(ctx, requestID)
+	require.NoError(t, err)
+	require.ErrorIs(t, cause, bls.ErrExecutionCancelled)
+	require.ElementsMatch(t, []peer.ID{confirming, refusing}, peers)
+	require.Equal(t, []peer.ID{confirming}, confirmed)
+}
+
+func TestHead_CancelExecution_NotOwned(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+	)
+
+	ctx := context.Background()
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	// We only know of the request from the state shared by the head node handling it.
+	record := bls.ExecutionRecord{
+		RequestID: requestID,
+		Head:      mocks.GenericPeerID,
+		Status:    execute.StatusExecuting,
+		StartedAt: time.Now(),
+	}
+	require.NoError(t, head.results.save(ctx, record))
+
+	_, _, err = head.CancelExecution(ctx, requestID)
+	require.ErrorIs(t, err, bls.ErrExecutionNotOwned)
+
+	// Once the execution is done, there is nothing to cancel.
+	record.Status = execute.StatusDone
+	record.CompletedAt = time.Now()
+	require.NoError(t, head.results.save(ctx, record))
+
+	_, _, err = head.CancelExecution(ctx, requestID)
+	require.ErrorIs(t, err, bls.ErrNotFound)
+}
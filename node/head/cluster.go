@@ -10,6 +10,7 @@ import (
 	"github.com/Maelkum/b7s/consensus"
 	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/models/request"
 	"github.com/Maelkum/b7s/models/response"
 )
@@ -70,6 +71,13 @@ func (h *HeadNode) formCluster(ctx context.Context, requestID string, replicas [
 				Str("request", requestID).
 				Msg("accounted consensus cluster response from roll called peer")
 
+			h.reportProgress(execute.Event{
+				Type:      execute.EventClusterConfirmation,
+				RequestID: requestID,
+				Peer:      rp,
+				Code:      fc.Code,
+			})
+
 			if fc.Code != codes.OK {
 				h.Log().Warn().
 					Stringer("peer", rp).
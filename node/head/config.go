@@ -1,9 +1,16 @@
 package head
 
 import (
+	"errors"
+	"fmt"
+	"maps"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
+	"github.com/libp2p/go-libp2p/core/peer"
+
 	"github.com/Maelkum/b7s/consensus"
+	"github.com/Maelkum/b7s/models/execute"
 )
 
 // Option can be used to set Node configuration options.
@@ -14,7 +21,19 @@ var DefaultConfig = Config{
 	RollCallTimeout:         DefaultRollCallTimeout,
 	ExecutionTimeout:        DefaultExecutionTimeout,
 	ClusterFormationTimeout: DefaultClusterFormationTimeout,
+	InventoryTimeout:        DefaultInventoryTimeout,
+	InstallTimeout:          DefaultInstallTimeout,
 	DefaultConsensus:        DefaultConsensusAlgorithm,
+	ExecutionResultTTL:      DefaultExecutionResultTTL,
+	ExecutionResultLimit:    DefaultExecutionResultLimit,
+	DefaultSelection:        DefaultSelectionStrategy,
+	SelectionWindow:         DefaultSelectionWindow,
+	ExecutionQueueSize:      DefaultExecutionQueueSize,
+	ReputationPolicy:        DefaultReputationPolicy,
+	ReputationHalfLife:      DefaultReputationHalfLife,
+	IdempotencyKeyTTL:       DefaultIdempotencyKeyTTL,
+	ResultCacheTTL:          DefaultResultCacheTTL,
+	ResultCacheSize:         DefaultResultCacheSize,
 }
 
 // Config represents the Node configuration.
@@ -22,9 +41,228 @@ type Config struct {
 	RollCallTimeout         time.Duration  // How long do we wait for roll call responses.
 	ExecutionTimeout        time.Duration  // How long does the head node wait for worker nodes to send their execution results.
 	ClusterFormationTimeout time.Duration  // How long do we wait for the nodes to form a cluster for an execution.
+	InventoryTimeout        time.Duration  // How long do we collect function inventory reports from peers.
+	InstallTimeout          time.Duration  // How long do we collect function install and uninstall responses from peers.
 	DefaultConsensus        consensus.Type // Default consensus algorithm to use.
+	ExecutionResultTTL      time.Duration  // How long do we keep execution results.
+	ExecutionResultLimit    uint           // Maximum number of execution results we keep.
+	DefaultSelection        string         // Default strategy for choosing among peers that reported for roll call.
+	SelectionWindow         time.Duration  // How long do we collect roll call responses for strategies that choose among multiple peers.
+	MaxConcurrentExecutions uint           // Maximum number of executions the node runs at the same time. Zero means no limit.
+	ExecutionQueueSize      uint           // How many execution requests can wait for an execution slot before new requests are rejected.
+	ReputationThreshold     float64        // Peers with reputation score below this are ignored or deprioritized on roll calls. Zero means reputation is not taken into account.
+	ReputationPolicy        string         // How roll call responses from peers with low reputation are handled.
+	ReputationHalfLife      time.Duration  // How long it takes for the recorded worker behavior to lose half of its weight.
+	IdempotencyKeyTTL       time.Duration  // How long do we remember idempotency keys of finished executions.
+	ResultCacheTTL          time.Duration  // How long do we keep cached results of cacheable functions.
+	ResultCacheSize         uint           // Maximum number of cached results.
+	RequireSignedResults    bool           // Drop unsigned execution results. Older worker nodes do not sign their results.
+
+	// Topics used to share execution request state with other head nodes. If empty, request state is not shared.
+	StateTopics []string
+	// Head nodes trusted to share execution request state. State shared by other peers is dropped.
+	StateHeads []peer.ID
+
+	// Custom selection strategies, in addition to the built-in ones.
+	SelectionStrategies map[string]SelectionStrategy
 }
 
+// Valid checks if the given configuration is correct.
 func (c Config) Valid() error {
-	return nil
+
+	var err *multierror.Error
+
+	if c.ExecutionResultTTL <= 0 {
+		err = multierror.Append(err, errors.New("execution result TTL must be positive"))
+	}
+
+	if c.ExecutionResultLimit == 0 {
+		err = multierror.Append(err, errors.New("execution result limit must be positive"))
+	}
+
+	if c.InstallTimeout <= 0 {
+		err = multierror.Append(err, errors.New("install timeout must be positive"))
+	}
+
+	if c.SelectionWindow <= 0 {
+		err = multierror.Append(err, errors.New("selection window must be positive"))
+	}
+
+	if c.SelectionWindow >= c.RollCallTimeout {
+		err = multierror.Append(err, errors.New("selection window must be shorter than the roll call timeout"))
+	}
+
+	if c.ReputationThreshold < 0 || c.ReputationThreshold > 1 {
+		err = multierror.Append(err, errors.New("reputation threshold must be between 0 and 1"))
+	}
+
+	if c.ReputationPolicy != ReputationIgnore && c.ReputationPolicy != ReputationDeprioritize {
+		err = multierror.Append(err, fmt.Errorf("unknown reputation policy: %v", c.ReputationPolicy))
+	}
+
+	if c.ReputationHalfLife <= 0 {
+		err = multierror.Append(err, errors.New("reputation half-life must be positive"))
+	}
+
+	if c.IdempotencyKeyTTL <= 0 {
+		err = multierror.Append(err, errors.New("idempotency key TTL must be positive"))
+	}
+
+	if c.ResultCacheTTL <= 0 {
+		err = multierror.Append(err, errors.New("result cache TTL must be positive"))
+	}
+
+	if c.ResultCacheSize == 0 {
+		err = multierror.Append(err, errors.New("result cache size must be positive"))
+	}
+
+	if len(c.StateTopics) > 0 && len(c.StateHeads) == 0 {
+		err = multierror.Append(err, errors.New("sharing request state requires a list of trusted head nodes"))
+	}
+
+	if !knownSelectionStrategy(c.DefaultSelection) {
+		_, ok := c.SelectionStrategies[c.DefaultSelection]
+		if !ok {
+			err = multierror.Append(err, fmt.Errorf("unknown selection strategy: %v", c.DefaultSelection))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// ExecutionResultTTL sets how long the node keeps results of finished executions.
+func ExecutionResultTTL(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ExecutionResultTTL = d
+	}
+}
+
+// ExecutionResultLimit sets the maximum number of execution results the node keeps.
+func ExecutionResultLimit(n uint) Option {
+	return func(cfg *Config) {
+		cfg.ExecutionResultLimit = n
+	}
+}
+
+// InstallTimeout sets how long the node collects function install and uninstall responses from peers.
+func InstallTimeout(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.InstallTimeout = d
+	}
+}
+
+// DefaultSelection sets the strategy used to choose among peers that reported for roll call, if the request does not specify one.
+func DefaultSelection(name string) Option {
+	return func(cfg *Config) {
+		cfg.DefaultSelection = name
+	}
+}
+
+// SelectionWindow sets how long roll call responses are collected before choosing among the peers that reported.
+func SelectionWindow(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.SelectionWindow = d
+	}
+}
+
+// MaxConcurrentExecutions sets the maximum number of executions the node runs at the same time.
+func MaxConcurrentExecutions(n uint) Option {
+	return func(cfg *Config) {
+		cfg.MaxConcurrentExecutions = n
+	}
+}
+
+// ExecutionQueueSize sets how many execution requests can wait for an execution slot.
+func ExecutionQueueSize(n uint) Option {
+	return func(cfg *Config) {
+		cfg.ExecutionQueueSize = n
+	}
+}
+
+// ReputationThreshold sets the reputation score below which peers are ignored or deprioritized on roll calls.
+func ReputationThreshold(n float64) Option {
+	return func(cfg *Config) {
+		cfg.ReputationThreshold = n
+	}
+}
+
+// ReputationPolicy sets how roll call responses from peers with low reputation are handled.
+func ReputationPolicy(policy string) Option {
+	return func(cfg *Config) {
+		cfg.ReputationPolicy = policy
+	}
+}
+
+// ReputationHalfLife sets how long it takes for the recorded worker behavior to lose half of its weight.
+func ReputationHalfLife(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ReputationHalfLife = d
+	}
+}
+
+// IdempotencyKeyTTL sets how long the node remembers idempotency keys of finished executions.
+func IdempotencyKeyTTL(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.IdempotencyKeyTTL = d
+	}
+}
+
+// ResultCacheTTL sets how long the node keeps cached results of cacheable functions.
+func ResultCacheTTL(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ResultCacheTTL = d
+	}
+}
+
+// ResultCacheSize sets the maximum number of cached results.
+func ResultCacheSize(n uint) Option {
+	return func(cfg *Config) {
+		cfg.ResultCacheSize = n
+	}
+}
+
+// RequireSignedResults sets whether the node drops unsigned execution results.
+func RequireSignedResults(b bool) Option {
+	return func(cfg *Config) {
+		cfg.RequireSignedResults = b
+	}
+}
+
+// ShareState sets the topics on which the node shares execution request state with other head nodes.
+func ShareState(topics ...string) Option {
+	return func(cfg *Config) {
+		cfg.StateTopics = topics
+	}
+}
+
+// StateHeads sets the head nodes trusted to share execution request state.
+func StateHeads(heads ...peer.ID) Option {
+	return func(cfg *Config) {
+		cfg.StateHeads = heads
+	}
+}
+
+// WithSelectionStrategy registers a custom selection strategy that requests can reference by name.
+func WithSelectionStrategy(name string, strategy SelectionStrategy) Option {
+	return func(cfg *Config) {
+		strategies := make(map[string]SelectionStrategy, len(cfg.SelectionStrategies)+1)
+		maps.Copy(strategies, cfg.SelectionStrategies)
+		strategies[name] = strategy
+
+		cfg.SelectionStrategies = strategies
+	}
+}
+
+func knownSelectionStrategy(name string) bool {
+
+	switch name {
+	case execute.SelectionFirstCome,
+		execute.SelectionRandom,
+		execute.SelectionLeastLoaded,
+		execute.SelectionLowestLatency:
+		return true
+
+	default:
+		return false
+	}
 }
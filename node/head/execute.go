@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/armon/go-metrics"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -15,6 +16,7 @@ import (
 	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/models/request"
 	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/node/aggregate"
 	"github.com/Maelkum/b7s/telemetry/tracing"
 )
 
@@ -41,7 +43,18 @@ func (h *HeadNode) processExecute(ctx context.Context, from peer.ID, req request
 		req.Config.NodeCount = -1
 	}
 
-	code, results, cluster, err := h.execute(ctx, requestID, req)
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		log.Warn().Err(err).Msg("execution request rejected")
+
+		err = h.Send(ctx, from, req.Response(codes.TooManyRequests, requestID).WithErrorMessage(err))
+		if err != nil {
+			return fmt.Errorf("could not send response: %w", err)
+		}
+		return nil
+	}
+
+	code, results, cluster, err := h.executeAndSave(ctx, requestID, req, ticket)
 	if err != nil {
 		log.Error().Err(err).Msg("execution failed")
 	}
@@ -49,10 +62,7 @@ func (h *HeadNode) processExecute(ctx context.Context, from peer.ID, req request
 	log.Info().Stringer("code", code).Msg("execution complete")
 
 	res := req.Response(code, requestID).WithResults(results).WithCluster(cluster)
-	// Communicate the reason for failure in these cases.
-	if errors.Is(err, bls.ErrRollCallTimeout) || errors.Is(err, bls.ErrExecutionNotEnoughNodes) {
-		res.ErrorMessage = err.Error()
-	}
+	res.ErrorMessage = bls.FailureMessage(err)
 
 	// Send the response, whatever it may be (success or failure).
 	err = h.Send(ctx, from, res)
@@ -63,6 +73,97 @@ func (h *HeadNode) processExecute(ctx context.Context, from peer.ID, req request
 	return nil
 }
 
+// executeAndSave runs the execution request once it gets an execution slot, and saves the outcome so it can be retrieved later.
+func (h *HeadNode) executeAndSave(ctx context.Context, requestID string, req request.Execute, ticket *ticket) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	record, err := h.runAndSave(ctx, requestID, req.Request, ticket, func(ctx context.Context, record *bls.ExecutionRecord) error {
+		var err error
+		record.Code, record.Results, record.Cluster, err = h.executeCached(ctx, requestID, req)
+		return err
+	})
+
+	return record.Code, record.Results, record.Cluster, err
+}
+
+// runAndSave runs the given function once the request gets an execution slot, and saves the outcome so it can be retrieved later.
+// The run function records the outcome of the execution in the given execution record.
+func (h *HeadNode) runAndSave(ctx context.Context, requestID string, req execute.Request, ticket *ticket, run func(context.Context, *bls.ExecutionRecord) error) (bls.ExecutionRecord, error) {
+
+	// Allow the execution to be cancelled.
+	execCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	h.updateRequest(requestID, func(state *requestState) {
+		state.cancel = cancel
+	})
+
+	// Record the request before starting, so other head nodes know about it and so we know it was orphaned if we stop before it's done.
+	status := execute.StatusRollCall
+	if ticket.queued {
+		status = execute.StatusQueued
+	}
+
+	record := bls.ExecutionRecord{
+		RequestID: requestID,
+		Request:   req,
+		Status:    status,
+		Head:      h.Host().ID(),
+		StartedAt: time.Now(),
+	}
+
+	h.saveResult(ctx, record)
+
+	err := h.admitAndRun(execCtx, requestID, ticket, &record, run)
+
+	record.Status = execute.StatusDone
+	if err != nil {
+		record.Status = execute.StatusFailed
+	}
+
+	if errors.Is(context.Cause(execCtx), bls.ErrExecutionCancelled) {
+		if err != nil {
+			h.Log().Debug().Err(err).Str("request", requestID).Msg("execution of cancelled request ended with an error")
+		}
+
+		record.Status = execute.StatusCancelled
+		err = bls.ErrExecutionCancelled
+	}
+
+	record.Message = bls.FailureMessage(err)
+	record.CompletedAt = time.Now()
+
+	h.saveResult(ctx, record)
+
+	// Execution is no longer in progress. We do this after saving the result so the request is never unaccounted for.
+	h.requests.Delete(requestID)
+
+	h.reportProgress(execute.Event{
+		Type:      execute.EventStatus,
+		RequestID: requestID,
+		Status:    record.Status,
+		Code:      record.Code,
+	})
+
+	return record, err
+}
+
+// admitAndRun waits for an execution slot and runs the given function.
+func (h *HeadNode) admitAndRun(ctx context.Context, requestID string, ticket *ticket, record *bls.ExecutionRecord, run func(context.Context, *bls.ExecutionRecord) error) error {
+
+	if ticket.queued {
+		h.setStatus(requestID, execute.StatusQueued)
+	}
+
+	err := ticket.wait(ctx)
+	if err != nil {
+		record.Code = codes.Error
+		return fmt.Errorf("could not get execution slot (request: %s): %w", requestID, err)
+	}
+	defer ticket.release()
+
+	return run(ctx, record)
+}
+
 // headExecute is called on the head node. The head node will publish a roll call and delegate an execution request to chosen nodes.
 // The returned map contains execution results, mapped to the peer IDs of peers who reported them.
 func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Execute) (codes.Code, execute.ResultMap, execute.Cluster, error) {
@@ -103,7 +204,8 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 	log.Info().Msg("processing execution request")
 
 	// Phase 1. - Issue roll call to nodes.
-	reportingPeers, err := h.executeRollCall(ctx, requestID, req, consensus)
+	h.setStatus(requestID, execute.StatusRollCall)
+	cluster, err := h.executeRollCall(ctx, requestID, req, consensus, nil)
 	if err != nil {
 		code := codes.Error
 		if errors.Is(err, bls.ErrRollCallTimeout) {
@@ -113,13 +215,21 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		return code, nil, execute.Cluster{}, fmt.Errorf("could not roll call peers (request: %s): %w", requestID, err)
 	}
 
-	cluster := execute.Cluster{
-		Peers: reportingPeers,
-	}
+	reportingPeers := cluster.Peers
+
+	h.updateRequest(requestID, func(state *requestState) {
+		state.peers = reportingPeers
+	})
+
+	// Keep track of the work we delegated to peers.
+	h.load.add(reportingPeers)
+	defer h.load.done(reportingPeers)
 
 	// Phase 2. - Request cluster formation, if we need consensus.
 	if consensusRequired(consensus) {
 
+		h.setStatus(requestID, execute.StatusFormingCluster)
+
 		log.Info().Strs("peers", bls.PeerIDsToStr(reportingPeers)).Msg("requesting cluster formation from peers who reported for roll call")
 
 		err := h.formCluster(ctx, requestID, reportingPeers, consensus)
@@ -136,6 +246,7 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 	}
 
 	// Phase 3. - Request execution.
+	h.setStatus(requestID, execute.StatusExecuting)
 
 	// Send the work order to peers in the cluster. Non-leaders will drop the request.
 	workOrder := req.WorkOrder(requestID)
@@ -177,8 +288,24 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 
 	results = h.gatherExecutionResults(ctx, requestID, reportingPeers)
 
+	// Replace peers that failed to execute the request, if the request allows it.
+	// Replacements would not be a part of the consensus cluster, so we only do this for executions without consensus.
+	var retriesErr error
+	retry := req.Config.Retry
+	if retry != nil && retry.MaxAttempts > 0 && !consensusRequired(consensus) {
+		cluster, results = h.replaceFailedPeers(ctx, requestID, req, cluster, results)
+		reportingPeers = cluster.Peers
+
+		// Retries did not help - none of the peers executed the request successfully.
+		if len(failedPeers(cluster.Peers, results)) == len(cluster.Peers) {
+			retriesErr = bls.ErrRetriesExhausted
+		}
+	}
+
 	log.Info().Int("cluster_size", len(reportingPeers)).Int("responded", len(results)).Msg("received execution responses")
 
+	h.recordDisagreements(ctx, results, req.Config.ResultAggregation)
+
 	// How many results do we have, and how many do we expect.
 	respondRatio := float64(len(results)) / float64(len(reportingPeers))
 	threshold := determineThreshold(req.Request)
@@ -191,7 +318,18 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		retcode = codes.PartialContent
 	}
 
-	return retcode, results, cluster, nil
+	// Check if enough of the results agree.
+	agreementThreshold := req.Config.AgreementThreshold
+	if retcode != codes.NoContent && agreementThreshold > 0 {
+
+		agreement := resultAgreement(results, req.Config.ResultAggregation)
+		if agreement < agreementThreshold {
+			log.Warn().Float64("expected", agreementThreshold).Float64("have", agreement).Msg("agreement threshold not met")
+			retcode = codes.ResultsDiverged
+		}
+	}
+
+	return retcode, results, cluster, retriesErr
 }
 
 func (h *HeadNode) processWorkOrderResponse(ctx context.Context, from peer.ID, res response.WorkOrder) error {
@@ -207,6 +345,30 @@ func (h *HeadNode) processWorkOrderResponse(ctx context.Context, from peer.ID, r
 	return nil
 }
 
+// resultAgreement returns the portion of results that agree - the relative size of the largest group of aggregated results.
+func resultAgreement(results execute.ResultMap, aggregation execute.ResultAggregation) float64 {
+
+	aggregated := aggregate.Aggregate(results, comparisonAggregation(aggregation))
+	if len(aggregated) == 0 {
+		return 0
+	}
+
+	// Frequency is in percentages.
+	return aggregated[0].Frequency / 100
+}
+
+// comparisonAggregation returns the aggregation used to determine which results agree.
+// Numeric aggregations combine all results into a single value, so for them results have to match exactly to agree.
+func comparisonAggregation(aggregation execute.ResultAggregation) execute.ResultAggregation {
+
+	switch aggregation.Strategy() {
+	case execute.AggregationMedian, execute.AggregationMean:
+		return execute.ResultAggregation{}
+	default:
+		return aggregation
+	}
+}
+
 func determineThreshold(req execute.Request) float64 {
 
 	if req.Config.Threshold > 0 && req.Config.Threshold <= 1 {
@@ -2,12 +2,14 @@ package head
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand/v2"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
@@ -26,11 +28,6 @@ func TestHead_Execute(t *testing.T) {
 		requestID = fmt.Sprintf("request-id-%v", rand.Int())
 		subgroup  = fmt.Sprintf("topic-%v", rand.Int())
 		req       = mocks.GenericExecutionRequest
-		res       = execute.NodeResult{
-			Result: mocks.GenericExecutionResult,
-		}
-
-		workerID = mocks.GenericPeerID
 
 		lock                 sync.Mutex
 		start                time.Time
@@ -38,6 +35,10 @@ func TestHead_Execute(t *testing.T) {
 		executionRequestSent time.Time
 	)
 
+	workers, keys := newWorkers(t, 1)
+	workerID := workers[0]
+	res := signedResult(t, keys[workerID], requestID, execute.NodeResult{Result: mocks.GenericExecutionResult})
+
 	head := createHeadNode(t)
 
 	// We know the request ID so we'll setup a roll call reply in advance.
@@ -108,6 +109,15 @@ func TestHead_Execute(t *testing.T) {
 
 	head.Core = core
 
+	// Record execution progress.
+	var events []execute.Event
+	head.progress.Set(requestID, func(event execute.Event) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		events = append(events, event)
+	})
+
 	// Main part of the test start time.
 	start = time.Now()
 
@@ -134,13 +144,261 @@ func TestHead_Execute(t *testing.T) {
 	require.NotZero(t, start)
 	require.True(t, rollCallPublished.After(start))
 	require.True(t, executionRequestSent.After(rollCallPublished))
+
+	// Verify progress was reported.
+	expectedEvents := []execute.Event{
+		{Type: execute.EventStatus, RequestID: requestID, Status: execute.StatusRollCall},
+		{Type: execute.EventRollCall, RequestID: requestID, Peer: workerID},
+		{Type: execute.EventStatus, RequestID: requestID, Status: execute.StatusExecuting},
+		{Type: execute.EventResult, RequestID: requestID, Peer: workerID, Code: res.Code},
+	}
+	require.Equal(t, expectedEvents, events)
+}
+
+func TestHead_Execute_AgreementThreshold(t *testing.T) {
+
+	workers, keys := newWorkers(t, 3)
+
+	// Setup a head node whose workers return the given outputs.
+	setupHead := func(t *testing.T, outputs []execute.RuntimeOutput) *HeadNode {
+		t.Helper()
+
+		head := createHeadNode(t)
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			for _, worker := range workers {
+				head.rollCall.add(rc.RequestID, rollCallResponse{
+					From: worker,
+					RollCall: response.RollCall{
+						Code:       codes.Accepted,
+						FunctionID: rc.FunctionID,
+						RequestID:  rc.RequestID,
+					},
+				})
+			}
+
+			return nil
+		}
+		core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			for i, worker := range workers {
+				head.workOrderResponses.Set(peerRequestKey(wo.RequestID, worker), signedResult(t, keys[worker], wo.RequestID, execute.NodeResult{
+					Result: execute.Result{Code: codes.OK, Result: outputs[i]},
+				}))
+			}
+
+			return nil
+		}
+		head.Core = core
+
+		return head
+	}
+
+	createRequest := func(threshold float64, aggregation execute.ResultAggregation) request.Execute {
+
+		req := mocks.GenericExecutionRequest
+		req.Config.NodeCount = len(workers)
+		req.Config.AgreementThreshold = threshold
+		req.Config.ResultAggregation = aggregation
+
+		return request.Execute{Request: req}
+	}
+
+	outputs := []execute.RuntimeOutput{
+		{Stdout: "first", Stderr: "first"},
+		{Stdout: "first", Stderr: "second"},
+		{Stdout: "second", Stderr: "third"},
+	}
+
+	t.Run("diverged results", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, outputs)
+
+		code, results, _, err := head.execute(context.Background(), newRequestID(), createRequest(0.6, execute.ResultAggregation{}))
+		require.NoError(t, err)
+		require.Equal(t, codes.ResultsDiverged, code)
+		require.Len(t, results, len(workers))
+	})
+	t.Run("agreement after aggregation", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, outputs)
+
+		aggregation := execute.ResultAggregation{Enable: true, Type: execute.AggregationStdout}
+		code, _, _, err := head.execute(context.Background(), newRequestID(), createRequest(0.6, aggregation))
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+	})
+	t.Run("numeric aggregation does not hide divergence", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, []execute.RuntimeOutput{
+			{Stdout: "1"},
+			{Stdout: "2"},
+			{Stdout: "3"},
+		})
+
+		aggregation := execute.ResultAggregation{Enable: true, Type: execute.AggregationMedian}
+		code, _, _, err := head.execute(context.Background(), newRequestID(), createRequest(0.6, aggregation))
+		require.NoError(t, err)
+		require.Equal(t, codes.ResultsDiverged, code)
+	})
+	t.Run("agreement not required", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, outputs)
+
+		code, _, _, err := head.execute(context.Background(), newRequestID(), createRequest(0, execute.ResultAggregation{}))
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+	})
+}
+
+func TestHead_Execute_DropsInvalidSignatures(t *testing.T) {
+
+	result := execute.NodeResult{
+		Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "generic-execution-result"}},
+	}
+
+	workers, keys := newWorkers(t, 5)
+
+	var (
+		signed   = workers[0]
+		unsigned = workers[1]
+		tampered = workers[2]
+		impostor = workers[3]
+		replayed = workers[4]
+	)
+
+	setup := func(t *testing.T, options ...Option) (*HeadNode, string) {
+		t.Helper()
+
+		head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t), options...)
+		require.NoError(t, err)
+
+		requestID := newRequestID()
+
+		tamperedResult := signedResult(t, keys[tampered], requestID, result)
+		tamperedResult.Result.Result.Stdout = "tampered-execution-result"
+
+		head.workOrderResponses.Set(peerRequestKey(requestID, signed), signedResult(t, keys[signed], requestID, result))
+		head.workOrderResponses.Set(peerRequestKey(requestID, unsigned), result)
+		head.workOrderResponses.Set(peerRequestKey(requestID, tampered), tamperedResult)
+		// Result signed by a different peer than the one that sent it.
+		head.workOrderResponses.Set(peerRequestKey(requestID, impostor), signedResult(t, keys[signed], requestID, result))
+		// Result signed as the result of a different request.
+		head.workOrderResponses.Set(peerRequestKey(requestID, replayed), signedResult(t, keys[replayed], newRequestID(), result))
+
+		return head, requestID
+	}
+
+	t.Run("unsigned results accepted by default", func(t *testing.T) {
+		t.Parallel()
+
+		head, requestID := setup(t)
+
+		results := head.gatherExecutionResults(context.Background(), requestID, workers)
+		require.Len(t, results, 2)
+		require.Contains(t, results, signed)
+		require.Contains(t, results, unsigned)
+	})
+	t.Run("unsigned results dropped when signatures are required", func(t *testing.T) {
+		t.Parallel()
+
+		head, requestID := setup(t, RequireSignedResults(true))
+
+		results := head.gatherExecutionResults(context.Background(), requestID, workers)
+		require.Len(t, results, 1)
+		require.Contains(t, results, signed)
+	})
+}
+
+func TestHead_Execute_SignedResultWithMetadata(t *testing.T) {
+
+	workers, keys := newWorkers(t, 1)
+	worker := workers[0]
+
+	head := createHeadNode(t)
+	requestID := newRequestID()
+
+	type metadata struct {
+		Region string `json:"region"`
+		Cost   int64  `json:"cost"`
+	}
+
+	encoded, err := json.Marshal(metadata{Region: "eu", Cost: 9007199254740993})
+	require.NoError(t, err)
+
+	res := signedResult(t, keys[worker], requestID, execute.NodeResult{
+		Result:   execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "generic-execution-result"}},
+		Metadata: encoded,
+	})
+
+	// Result is received over the wire.
+	payload, err := json.Marshal(response.WorkOrder{RequestID: requestID, Code: codes.OK, Result: res})
+	require.NoError(t, err)
+
+	var received response.WorkOrder
+	require.NoError(t, json.Unmarshal(payload, &received))
+
+	head.workOrderResponses.Set(peerRequestKey(requestID, worker), received.Result)
+
+	results := head.gatherExecutionResults(context.Background(), requestID, workers)
+	require.Contains(t, results, worker)
+	require.JSONEq(t, string(encoded), string(results[worker].Metadata))
 }
 
 func createHeadNode(t *testing.T) *HeadNode {
 	t.Helper()
 
-	head, err := New(mocks.BaselineNodeCore(t))
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
 	require.NoError(t, err)
 
 	return head
 }
+
+// newWorkers creates identities for the given number of workers, so tests can produce signed execution results.
+func newWorkers(t *testing.T, n int) ([]peer.ID, map[peer.ID]crypto.PrivKey) {
+	t.Helper()
+
+	var (
+		peers = make([]peer.ID, 0, n)
+		keys  = make(map[peer.ID]crypto.PrivKey, n)
+	)
+
+	for i := 0; i < n; i++ {
+
+		key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+		require.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+
+		peers = append(peers, id)
+		keys[id] = key
+	}
+
+	return peers, keys
+}
+
+// signedResult returns the execution result signed with the given key, as a worker would send it.
+func signedResult(t *testing.T, key crypto.PrivKey, requestID string, res execute.NodeResult) execute.NodeResult {
+	t.Helper()
+
+	err := res.Sign(key, requestID)
+	require.NoError(t, err)
+
+	return res
+}
@@ -2,8 +2,11 @@ package head
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
@@ -17,10 +20,13 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 	exctx, exCancel := context.WithTimeout(ctx, h.cfg.ExecutionTimeout)
 	defer exCancel()
 
+	start := time.Now()
+
 	type aggregatedResult struct {
-		result   execute.Result
-		peers    []peer.ID
-		metadata map[peer.ID]any
+		result    execute.Result
+		peers     []peer.ID
+		metadata  map[peer.ID]json.RawMessage
+		cacheable bool // All peers with this result marked it as cacheable.
 	}
 
 	var (
@@ -52,17 +58,20 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 
 			h.Log().Info().Stringer("peer", sender).Str("request", requestID).Msg("accounted execution response from peer")
 
-			pub, err := sender.ExtractPublicKey()
+			err := h.verifyResultSignature(ctx, requestID, sender, res)
 			if err != nil {
-				h.Log().Error().Err(err).Msg("could not derive public key from peer ID")
+				h.Log().Error().Err(err).Stringer("peer", sender).Str("request", requestID).Msg("dropping execution response with invalid signature")
 				return
 			}
 
-			err = res.VerifySignature(pub)
-			if err != nil {
-				h.Log().Error().Err(err).Msg("could not verify signature of an execution response")
-				return
-			}
+			h.recordResult(ctx, sender, res, time.Since(start))
+
+			h.reportProgress(execute.Event{
+				Type:      execute.EventResult,
+				RequestID: requestID,
+				Peer:      sender,
+				Code:      res.Code,
+			})
 
 			lock.Lock()
 			defer lock.Unlock()
@@ -75,9 +84,10 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 					peers: []peer.ID{
 						sender,
 					},
-					metadata: map[peer.ID]any{
+					metadata: map[peer.ID]json.RawMessage{
 						sender: res.Metadata,
 					},
+					cacheable: res.Cacheable,
 				}
 				return
 			}
@@ -85,6 +95,7 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 			// Record which peers have this result, and their metadata.
 			result.peers = append(result.peers, sender)
 			result.metadata[sender] = res.Metadata
+			result.cacheable = result.cacheable && res.Cacheable
 
 			results[reskey] = result
 
@@ -94,8 +105,9 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 
 				for _, peer := range result.peers {
 					out[peer] = execute.NodeResult{
-						Result:   result.result,
-						Metadata: result.metadata[peer],
+						Result:    result.result,
+						Metadata:  result.metadata[peer],
+						Cacheable: result.cacheable,
 					}
 				}
 			}
@@ -114,6 +126,8 @@ func (h *HeadNode) gatherExecutionResults(ctx context.Context, requestID string,
 	exctx, exCancel := context.WithTimeout(ctx, h.cfg.ExecutionTimeout)
 	defer exCancel()
 
+	start := time.Now()
+
 	var (
 		results execute.ResultMap = make(map[peer.ID]execute.NodeResult)
 		reslock sync.Mutex
@@ -131,11 +145,30 @@ func (h *HeadNode) gatherExecutionResults(ctx context.Context, requestID string,
 			key := peerRequestKey(requestID, peer)
 			res, ok := h.workOrderResponses.WaitFor(exctx, key)
 			if !ok {
+				// Peer accepted the roll call but did not deliver a result in time. Cancelled executions are not held against the peer.
+				if ctx.Err() == nil {
+					h.reputation.record(ctx, peer, outcomeNoResult, 0)
+				}
 				return
 			}
 
 			h.Log().Info().Str("peer", peer.String()).Msg("accounted execution response from peer")
 
+			err := h.verifyResultSignature(ctx, requestID, peer, res)
+			if err != nil {
+				h.Log().Error().Err(err).Stringer("peer", peer).Str("request", requestID).Msg("dropping execution response with invalid signature")
+				return
+			}
+
+			h.recordResult(ctx, peer, res, time.Since(start))
+
+			h.reportProgress(execute.Event{
+				Type:      execute.EventResult,
+				RequestID: requestID,
+				Peer:      peer,
+				Code:      res.Code,
+			})
+
 			reslock.Lock()
 			defer reslock.Unlock()
 			results[peer] = res
@@ -146,3 +179,43 @@ func (h *HeadNode) gatherExecutionResults(ctx context.Context, requestID string,
 
 	return results
 }
+
+// verifyResultSignature verifies that the execution result was signed by the peer that sent it, as the result of the given request.
+// Responses that fail verification are recorded in metrics and count against the peer reputation.
+// Unsigned results, sent by older worker nodes, are accepted unless the node requires signed results.
+func (h *HeadNode) verifyResultSignature(ctx context.Context, requestID string, sender peer.ID, res execute.NodeResult) error {
+
+	if res.Signature == "" && !h.cfg.RequireSignedResults {
+		h.Metrics().IncrCounter(unsignedResultsMetric, 1)
+		h.Log().Warn().Stringer("peer", sender).Str("request", requestID).Msg("accepting unsigned execution result")
+		return nil
+	}
+
+	err := verifyResultSignature(requestID, sender, res)
+	if err != nil {
+		h.Metrics().IncrCounter(invalidResultSignaturesMetric, 1)
+		h.reputation.record(ctx, sender, outcomeInvalidSignature, 0)
+		return err
+	}
+
+	return nil
+}
+
+func verifyResultSignature(requestID string, sender peer.ID, res execute.NodeResult) error {
+
+	if res.Signature == "" {
+		return errors.New("execution result is not signed")
+	}
+
+	pub, err := sender.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("could not derive public key from peer ID: %w", err)
+	}
+
+	err = res.VerifySignature(pub, requestID)
+	if err != nil {
+		return fmt.Errorf("could not verify signature of an execution response: %w", err)
+	}
+
+	return nil
+}
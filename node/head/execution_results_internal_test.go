@@ -0,0 +1,72 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_GatherExecutionResultsPBFT_Cacheable(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		cacheable []bool
+		expected  bool
+	}{
+		{
+			name:      "all peers mark result as cacheable",
+			cacheable: []bool{true, true},
+			expected:  true,
+		},
+		{
+			name:      "one peer does not mark result as cacheable",
+			cacheable: []bool{true, false},
+			expected:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				requestID = fmt.Sprintf("request-id-%v", rand.Int())
+				timestamp = time.Now().UTC()
+			)
+
+			// Cluster of four peers - two matching results are enough.
+			workers, keys := newWorkers(t, 4)
+			head := createHeadNode(t)
+
+			result := mocks.GenericExecutionResult
+			result.Code = codes.OK
+
+			for i, cacheable := range test.cacheable {
+				worker := workers[i]
+
+				res := execute.NodeResult{
+					Result:    result,
+					Cacheable: cacheable,
+				}
+				res.PBFT.RequestTimestamp = timestamp
+
+				head.workOrderResponses.Set(peerRequestKey(requestID, worker), signedResult(t, keys[worker], requestID, res))
+			}
+
+			results := head.gatherExecutionResultsPBFT(context.Background(), requestID, workers)
+			require.Len(t, results, len(test.cacheable))
+
+			for _, res := range results {
+				require.Equal(t, test.expected, res.Cacheable)
+			}
+		})
+	}
+}
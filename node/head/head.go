@@ -3,14 +3,18 @@ package head
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/armon/go-metrics"
 	"github.com/google/uuid"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/Maelkum/b7s/info"
+	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/models/response"
 	"github.com/Maelkum/b7s/node"
+	"github.com/Maelkum/b7s/node/internal/syncmap"
 	"github.com/Maelkum/b7s/node/internal/waitmap"
 )
 
@@ -22,9 +26,37 @@ type HeadNode struct {
 	rollCall           *rollCallQueue
 	consensusResponses *waitmap.WaitMap[string, response.FormCluster]
 	workOrderResponses *waitmap.WaitMap[string, execute.NodeResult]
+	cancelResponses    *waitmap.WaitMap[string, response.CancelExecution]
+
+	requests  *syncmap.Map[string, requestState]        // requests maps request ID to the state of an in-progress execution.
+	progress  *syncmap.Map[string, func(execute.Event)] // progress maps request ID to the subscriber for execution progress.
+	batches   *syncmap.Map[string, *batchProgress]      // batches maps request ID to the progress of an in-progress batch execution.
+	inventory *syncmap.Map[string, chan peerInventory]  // inventory maps request ID to the reports of an in-progress function inventory.
+	installs  *syncmap.Map[string, chan peerResponse]   // installs maps request ID to the responses for an in-progress function install or uninstall.
+	workers   *syncmap.Map[peer.ID, struct{}]           // workers is the set of peers known to be worker nodes.
+	results   *resultStore
+
+	installResults *syncmap.Map[string, bls.FunctionInstall] // installResults maps request ID to the outcome of a function install started asynchronously.
+
+	schedules  *scheduler
+	reputation *reputations
+
+	admission   *admission
+	idempotency *idempotencyKeys
+	resultCache *resultCache
+
+	strategies map[string]SelectionStrategy // strategies maps names to the available selection strategies.
+	load       *peerLoad
+}
+
+// Store is the persistent storage used by the head node.
+type Store interface {
+	bls.ExecutionStore
+	bls.ScheduleStore
+	bls.ReputationStore
 }
 
-func New(core node.Core, options ...Option) (*HeadNode, error) {
+func New(core node.Core, store Store, options ...Option) (*HeadNode, error) {
 
 	// Initialize config.
 	cfg := DefaultConfig
@@ -44,8 +76,31 @@ func New(core node.Core, options ...Option) (*HeadNode, error) {
 		rollCall:           newQueue(rollCallQueueBufferSize),
 		consensusResponses: waitmap.New[string, response.FormCluster](0),
 		workOrderResponses: waitmap.New[string, execute.NodeResult](executionResultCacheSize),
+		cancelResponses:    waitmap.New[string, response.CancelExecution](cancelResponseCacheSize),
+
+		requests:  syncmap.New[string, requestState](),
+		progress:  syncmap.New[string, func(execute.Event)](),
+		batches:   syncmap.New[string, *batchProgress](),
+		inventory: syncmap.New[string, chan peerInventory](),
+		installs:  syncmap.New[string, chan peerResponse](),
+		workers:   syncmap.New[peer.ID, struct{}](),
+		results:   newResultStore(core.Log().With().Str("component", "results").Logger(), store, cfg.ExecutionResultTTL, cfg.ExecutionResultLimit),
+
+		installResults: syncmap.New[string, bls.FunctionInstall](),
+
+		schedules:  newScheduler(store, scheduleHistorySize),
+		reputation: newReputations(core.Log().With().Str("component", "reputation").Logger(), store, cfg.ReputationHalfLife),
+
+		admission:   newAdmission(core.Metrics(), cfg.MaxConcurrentExecutions, cfg.ExecutionQueueSize),
+		idempotency: newIdempotencyKeys(cfg.IdempotencyKeyTTL),
+		resultCache: newResultCache(cfg.ResultCacheTTL, cfg.ResultCacheSize),
+
+		load: newPeerLoad(),
 	}
 
+	head.strategies = head.builtinStrategies()
+	maps.Copy(head.strategies, cfg.SelectionStrategies)
+
 	head.Metrics().SetGaugeWithLabels(node.NodeInfoMetric, 1,
 		[]metrics.Label{
 			{Name: "id", Value: head.ID()},
@@ -57,6 +112,39 @@ func New(core node.Core, options ...Option) (*HeadNode, error) {
 }
 
 func (h *HeadNode) Run(ctx context.Context) error {
+
+	// Load results of past executions.
+	unfinished, err := h.results.load(ctx)
+	if err != nil {
+		return fmt.Errorf("could not load execution results: %w", err)
+	}
+
+	// Executions we were running before the restart will never complete.
+	orphaned := h.markOrphaned(ctx, unfinished)
+	if len(orphaned) > 0 && len(h.cfg.StateTopics) > 0 {
+		go h.shareOrphaned(ctx, orphaned)
+	}
+
+	// Periodically remove expired execution results and idempotency keys.
+	go h.runResultPurgeLoop(ctx)
+
+	err = h.schedules.load(ctx)
+	if err != nil {
+		return fmt.Errorf("could not load schedules: %w", err)
+	}
+
+	go h.runScheduleLoop(ctx)
+
+	err = h.reputation.load(ctx)
+	if err != nil {
+		return fmt.Errorf("could not load worker reputations: %w", err)
+	}
+
+	// Peers we have reputations for have executed functions, so they are workers.
+	for _, reputation := range h.reputation.list() {
+		h.markWorker(reputation.Peer)
+	}
+
 	return h.Core.Run(ctx, h.process)
 }
 
@@ -0,0 +1,193 @@
+package head
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+)
+
+// idempotencyKeys keeps track of executions started with an idempotency key, so retried requests
+// join the existing execution instead of starting a new one. Keys of finished executions are kept for the configured TTL.
+type idempotencyKeys struct {
+	ttl time.Duration
+
+	lock sync.Mutex
+	keys map[string]*idempotencyEntry
+}
+
+// idempotencyEntry describes the execution started for an idempotency key.
+// Outcome of the execution is set before the done channel is closed.
+type idempotencyEntry struct {
+	hash      string
+	requestID string
+	done      chan struct{}
+	expires   time.Time // Zero while the execution is in progress.
+
+	code    codes.Code
+	results execute.ResultMap
+	cluster execute.Cluster
+	err     error
+}
+
+func newIdempotencyKeys(ttl time.Duration) *idempotencyKeys {
+
+	ik := idempotencyKeys{
+		ttl:  ttl,
+		keys: make(map[string]*idempotencyEntry),
+	}
+
+	return &ik
+}
+
+// claim returns the entry for the given key, creating it if the key is new or expired.
+// Requests reusing a key must have the same hash as the request that created the entry.
+func (k *idempotencyKeys) claim(key string, hash string) (*idempotencyEntry, bool, error) {
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	entry, ok := k.keys[key]
+	if ok && !entry.expired(time.Now()) {
+		if entry.hash != hash {
+			return nil, false, bls.ErrIdempotencyKeyMismatch
+		}
+
+		return entry, false, nil
+	}
+
+	entry = &idempotencyEntry{
+		hash:      hash,
+		requestID: newRequestID(),
+		done:      make(chan struct{}),
+	}
+	k.keys[key] = entry
+
+	return entry, true, nil
+}
+
+// complete records the outcome of the execution started for the key and starts the retention period.
+func (k *idempotencyKeys) complete(key string, code codes.Code, results execute.ResultMap, cluster execute.Cluster) {
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	entry, ok := k.keys[key]
+	if !ok {
+		return
+	}
+
+	entry.code = code
+	entry.results = results
+	entry.cluster = cluster
+	entry.expires = time.Now().Add(k.ttl)
+
+	close(entry.done)
+}
+
+// release drops the key if the execution could not be started, so the request can be retried with the same key.
+// Requests that already joined the execution get the given error.
+func (k *idempotencyKeys) release(key string, code codes.Code, err error) {
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	entry, ok := k.keys[key]
+	if !ok {
+		return
+	}
+
+	entry.code = code
+	entry.err = err
+	delete(k.keys, key)
+
+	close(entry.done)
+}
+
+// purge removes keys of executions that finished longer than TTL ago.
+func (k *idempotencyKeys) purge(now time.Time) {
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	for key, entry := range k.keys {
+		if entry.expired(now) {
+			delete(k.keys, key)
+		}
+	}
+}
+
+func (e *idempotencyEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// requestHash returns the hash used to verify that requests reusing an idempotency key are the same as the original one.
+func requestHash(req request.Execute) (string, error) {
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("could not encode request: %w", err)
+	}
+
+	hash := sha256.Sum256(payload)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// executeIdempotent starts the execution for the given idempotency key, unless one was already started.
+// The execution is detached from the given context, so a client retrying the request can join it.
+func (h *HeadNode) executeIdempotent(ctx context.Context, key string, req request.Execute, progress func(execute.Event)) (*idempotencyEntry, error) {
+
+	hash, err := requestHash(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, created, err := h.idempotency.claim(key, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created {
+		h.Log().Debug().Str("request", entry.requestID).Str("idempotency_key", key).Msg("request joined existing execution")
+		return entry, nil
+	}
+
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		h.idempotency.release(key, codes.TooManyRequests, err)
+		return nil, err
+	}
+
+	requestID := entry.requestID
+	h.acceptRequest(requestID, ticket)
+
+	if progress != nil {
+		h.progress.Set(requestID, progress)
+	}
+
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		if progress != nil {
+			defer h.progress.Delete(requestID)
+		}
+
+		code, results, cluster, err := h.executeAndSave(ctx, requestID, req, ticket)
+		if err != nil {
+			h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
+		}
+
+		h.idempotency.complete(key, code, results, cluster)
+	}()
+
+	return entry, nil
+}
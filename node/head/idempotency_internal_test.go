@@ -0,0 +1,208 @@
+package head
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_IdempotencyKeys(t *testing.T) {
+
+	const key = "idempotency-key"
+
+	t.Run("same request joins existing entry", func(t *testing.T) {
+		t.Parallel()
+
+		keys := newIdempotencyKeys(time.Hour)
+
+		entry, created, err := keys.claim(key, "hash")
+		require.NoError(t, err)
+		require.True(t, created)
+
+		joined, created, err := keys.claim(key, "hash")
+		require.NoError(t, err)
+		require.False(t, created)
+		require.Equal(t, entry.requestID, joined.requestID)
+
+		keys.complete(key, codes.OK, mocks.GenericExecutionResultMap, execute.Cluster{})
+
+		<-joined.done
+		require.Equal(t, codes.OK, joined.code)
+		require.Equal(t, mocks.GenericExecutionResultMap, joined.results)
+	})
+	t.Run("different request is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		keys := newIdempotencyKeys(time.Hour)
+
+		_, _, err := keys.claim(key, "hash")
+		require.NoError(t, err)
+
+		_, _, err = keys.claim(key, "other-hash")
+		require.ErrorIs(t, err, bls.ErrIdempotencyKeyMismatch)
+	})
+	t.Run("released key can be reused", func(t *testing.T) {
+		t.Parallel()
+
+		keys := newIdempotencyKeys(time.Hour)
+
+		entry, _, err := keys.claim(key, "hash")
+		require.NoError(t, err)
+
+		keys.release(key, codes.TooManyRequests, bls.ErrTooManyRequests)
+
+		<-entry.done
+		require.ErrorIs(t, entry.err, bls.ErrTooManyRequests)
+
+		_, created, err := keys.claim(key, "other-hash")
+		require.NoError(t, err)
+		require.True(t, created)
+	})
+	t.Run("expired keys are purged", func(t *testing.T) {
+		t.Parallel()
+
+		keys := newIdempotencyKeys(time.Hour)
+
+		_, _, err := keys.claim(key, "hash")
+		require.NoError(t, err)
+
+		// Keys of executions in progress never expire.
+		keys.purge(time.Now().Add(2 * time.Hour))
+		require.Len(t, keys.keys, 1)
+
+		keys.complete(key, codes.OK, nil, execute.Cluster{})
+
+		keys.purge(time.Now())
+		require.Len(t, keys.keys, 1)
+
+		keys.purge(time.Now().Add(2 * time.Hour))
+		require.Empty(t, keys.keys)
+	})
+}
+
+func TestHead_ExecuteFunctionIdempotent(t *testing.T) {
+
+	const key = "idempotency-key"
+
+	// Setup a head node where a single worker responds to roll calls and executes the function.
+	setupHead := func(t *testing.T, rollCalls *atomic.Int32) *HeadNode {
+		t.Helper()
+
+		workers, keys := newWorkers(t, 1)
+		worker := workers[0]
+
+		head := createHeadNode(t)
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			rollCalls.Add(1)
+
+			head.rollCall.add(rc.RequestID, rollCallResponse{
+				From: worker,
+				RollCall: response.RollCall{
+					Code:       codes.Accepted,
+					FunctionID: rc.FunctionID,
+					RequestID:  rc.RequestID,
+				},
+			})
+
+			return nil
+		}
+		core.SendToManyFunc = func(_ context.Context, _ []peer.ID, msg bls.Message, _ bool) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			res := signedResult(t, keys[worker], wo.RequestID, execute.NodeResult{Result: mocks.GenericExecutionResult})
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, worker), res)
+
+			return nil
+		}
+		head.Core = core
+
+		return head
+	}
+
+	t.Run("repeated request returns the original result", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, &rollCalls)
+
+		code, requestID, results, _, err := head.ExecuteFunctionIdempotent(context.Background(), key, mocks.GenericExecutionRequest, "", nil)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+		require.Len(t, results, 1)
+
+		repeatedCode, repeatedID, repeatedResults, _, err := head.ExecuteFunctionIdempotent(context.Background(), key, mocks.GenericExecutionRequest, "", nil)
+		require.NoError(t, err)
+		require.Equal(t, code, repeatedCode)
+		require.Equal(t, requestID, repeatedID)
+		require.Equal(t, results, repeatedResults)
+
+		id, err := head.ExecuteFunctionAsyncIdempotent(context.Background(), key, mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+		require.Equal(t, requestID, id)
+
+		require.Equal(t, int32(1), rollCalls.Load())
+	})
+	t.Run("repeated request with a different body is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, &rollCalls)
+
+		_, _, _, _, err := head.ExecuteFunctionIdempotent(context.Background(), key, mocks.GenericExecutionRequest, "", nil)
+		require.NoError(t, err)
+
+		req := mocks.GenericExecutionRequest
+		req.Method = "other-method"
+
+		_, _, _, _, err = head.ExecuteFunctionIdempotent(context.Background(), key, req, "", nil)
+		require.ErrorIs(t, err, bls.ErrIdempotencyKeyMismatch)
+
+		_, err = head.ExecuteFunctionAsyncIdempotent(context.Background(), key, mocks.GenericExecutionRequest, "other-topic")
+		require.ErrorIs(t, err, bls.ErrIdempotencyKeyMismatch)
+
+		require.Equal(t, int32(1), rollCalls.Load())
+	})
+	t.Run("execution outlives the request that started it", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, &rollCalls)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Request is abandoned, unless the execution is already done.
+		_, requestID, _, _, _ := head.ExecuteFunctionIdempotent(ctx, key, mocks.GenericExecutionRequest, "", nil)
+		require.NotEmpty(t, requestID)
+
+		code, repeatedID, results, _, err := head.ExecuteFunctionIdempotent(context.Background(), key, mocks.GenericExecutionRequest, "", nil)
+		require.NoError(t, err)
+		require.Equal(t, requestID, repeatedID)
+		require.Equal(t, codes.OK, code)
+		require.Len(t, results, 1)
+
+		require.Equal(t, int32(1), rollCalls.Load())
+	})
+}
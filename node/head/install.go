@@ -1,19 +1,223 @@
 package head
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
 
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/response"
 )
 
+// peerResponse is the response to a function install or uninstall message, sent by a peer.
+type peerResponse struct {
+	From    peer.ID
+	Code    codes.Code
+	Message string
+}
+
+// functionResponses tracks the responses to a published function install or uninstall message.
+type functionResponses struct {
+	head *HeadNode
+	log  zerolog.Logger
+
+	requestID string
+	expected  []peer.ID // Known workers on the topic at the time the message was published.
+	responses chan peerResponse
+}
+
+// functionOutcome groups peers by their response to a function install or uninstall message.
+type functionOutcome struct {
+	succeeded []peer.ID
+	failed    []bls.FunctionInstallFailure
+	noAnswer  []peer.ID
+}
+
+// publishFunctionMessage publishes the function install or uninstall message and starts collecting responses.
+func (h *HeadNode) publishFunctionMessage(ctx context.Context, requestID string, cid string, msg bls.Message, subgroup string) (*functionResponses, error) {
+
+	subgroup = cmp.Or(subgroup, bls.DefaultTopic)
+
+	log := h.Log().With().Str("request", requestID).Str("topic", subgroup).Str("cid", cid).Str("type", msg.Type()).Logger()
+
+	responses := make(chan peerResponse, functionInstallBufferSize)
+	h.installs.Set(requestID, responses)
+
+	log.Debug().Msg("publishing function message")
+
+	err := h.PublishToTopic(ctx, subgroup, msg)
+	if err != nil {
+		h.installs.Delete(requestID)
+		return nil, fmt.Errorf("could not publish message: %w", err)
+	}
+
+	fr := functionResponses{
+		head:      h,
+		log:       log,
+		requestID: requestID,
+		expected:  h.knownWorkers(h.TopicPeers(subgroup)),
+		responses: responses,
+	}
+
+	return &fr, nil
+}
+
+// collect gathers responses until all expected peers respond, or until the install timeout.
+func (r *functionResponses) collect(ctx context.Context) functionOutcome {
+
+	defer r.head.installs.Delete(r.requestID)
+
+	tctx, cancel := context.WithTimeout(ctx, r.head.cfg.InstallTimeout)
+	defer cancel()
+
+	answers := make(map[peer.ID]peerResponse)
+
+collect:
+	for {
+		select {
+		case res := <-r.responses:
+
+			answers[res.From] = res
+
+			if len(r.expected) > 0 && answered(r.expected, answers) {
+				break collect
+			}
+
+		case <-tctx.Done():
+			break collect
+		}
+	}
+
+	outcome := functionOutcome{
+		succeeded: make([]peer.ID, 0),
+		failed:    make([]bls.FunctionInstallFailure, 0),
+		noAnswer:  make([]peer.ID, 0),
+	}
+
+	for id, res := range answers {
+		switch res.Code {
+		case codes.OK, codes.Accepted:
+			outcome.succeeded = append(outcome.succeeded, id)
+		default:
+			outcome.failed = append(outcome.failed, bls.FunctionInstallFailure{Peer: id, Error: res.Message})
+		}
+	}
+
+	for _, id := range r.expected {
+		_, ok := answers[id]
+		if !ok {
+			outcome.noAnswer = append(outcome.noAnswer, id)
+		}
+	}
+
+	slices.Sort(outcome.succeeded)
+	slices.Sort(outcome.noAnswer)
+	slices.SortFunc(outcome.failed, func(a, b bls.FunctionInstallFailure) int {
+		return cmp.Compare(a.Peer, b.Peer)
+	})
+
+	r.log.Info().
+		Int("succeeded", len(outcome.succeeded)).
+		Int("failed", len(outcome.failed)).
+		Int("no_answer", len(outcome.noAnswer)).
+		Msg("function responses collected")
+
+	return outcome
+}
+
+func (o functionOutcome) install(requestID string, cid string) bls.FunctionInstall {
+	return bls.FunctionInstall{
+		RequestID:   requestID,
+		CID:         cid,
+		Done:        true,
+		Succeeded:   o.succeeded,
+		Failed:      o.failed,
+		NoAnswer:    o.noAnswer,
+		CompletedAt: time.Now().UTC(),
+	}
+}
+
+func (o functionOutcome) uninstall(requestID string, cid string) bls.FunctionUninstall {
+	return bls.FunctionUninstall{
+		RequestID:   requestID,
+		CID:         cid,
+		Succeeded:   o.succeeded,
+		Failed:      o.failed,
+		NoAnswer:    o.noAnswer,
+		CompletedAt: time.Now().UTC(),
+	}
+}
+
+func answered(peers []peer.ID, answers map[peer.ID]peerResponse) bool {
+
+	for _, id := range peers {
+		_, ok := answers[id]
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// purgeInstallResults removes outcomes of asynchronous function installs that completed longer than the retention period ago.
+func (h *HeadNode) purgeInstallResults(now time.Time) {
+
+	h.installResults.WithLock(func(results map[string]bls.FunctionInstall) {
+		for id, result := range results {
+			if result.Done && now.Sub(result.CompletedAt) > functionInstallResultTTL {
+				delete(results, id)
+			}
+		}
+	})
+}
+
 func (h *HeadNode) processInstallFunctionResponse(ctx context.Context, from peer.ID, res response.InstallFunction) error {
 
 	h.Log().Trace().
 		Stringer("from", from).
+		Str("request", res.RequestID).
 		Str("cid", res.CID).
+		Stringer("code", res.Code).
 		Msg("function install response received")
 
+	return h.recordFunctionResponse(res.RequestID, peerResponse{From: from, Code: res.Code, Message: res.Message})
+}
+
+func (h *HeadNode) processUninstallFunctionResponse(ctx context.Context, from peer.ID, res response.UninstallFunction) error {
+
+	log := h.Log().With().Stringer("from", from).Str("request", res.RequestID).Str("cid", res.CID).Stringer("code", res.Code).Logger()
+
+	if res.Code != codes.OK {
+		log.Warn().Str("message", res.Message).Msg("peer did not uninstall function")
+	} else {
+		log.Debug().Msg("function uninstall response received")
+	}
+
+	return h.recordFunctionResponse(res.RequestID, peerResponse{From: from, Code: res.Code, Message: res.Message})
+}
+
+// recordFunctionResponse hands the response to the function install or uninstall in progress, if there is one.
+func (h *HeadNode) recordFunctionResponse(requestID string, res peerResponse) error {
+
+	h.markWorker(res.From)
+
+	responses, ok := h.installs.Get(requestID)
+	if !ok {
+		return nil
+	}
+
+	select {
+	case responses <- res:
+	default:
+		return fmt.Errorf("function response buffer full, dropping response (request: %s)", requestID)
+	}
+
 	return nil
 }
@@ -0,0 +1,192 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_InstallFunction(t *testing.T) {
+
+	const (
+		cid = "dummy-cid"
+	)
+
+	var (
+		workers = mocks.GenericPeerIDs[:3]
+		topic   = mocks.GenericPeerIDs[:4] // Last peer is another head node.
+	)
+
+	// Setup a head node where the first worker installs the function, the second one fails and the third one does not respond.
+	// Another head node is subscribed to the topic too, but it never answers install messages.
+	setupHead := func(t *testing.T, responders []peer.ID) *HeadNode {
+		t.Helper()
+
+		head := createHeadNode(t)
+		head.cfg.InstallTimeout = 100 * time.Millisecond
+
+		for _, worker := range workers {
+			head.markWorker(worker)
+		}
+
+		core := mocks.BaselineNodeCore(t)
+		core.TopicPeersFunc = func(string) []peer.ID {
+			return topic
+		}
+		core.PublishToTopicFunc = func(ctx context.Context, _ string, msg bls.Message) error {
+
+			req, ok := any(msg).(*request.InstallFunction)
+			require.True(t, ok)
+			require.NotEmpty(t, req.RequestID)
+
+			for i, worker := range responders {
+
+				res := req.Response(codes.Accepted, "installed")
+				if i == 1 {
+					res = req.Response(codes.Error, "could not download function")
+				}
+
+				err := head.processInstallFunctionResponse(ctx, worker, *res)
+				require.NoError(t, err)
+			}
+
+			return nil
+		}
+		head.Core = core
+
+		return head
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, workers[:2])
+
+		install, err := head.InstallFunction(context.Background(), "", cid, "")
+		require.NoError(t, err)
+
+		require.True(t, install.Done)
+		require.Equal(t, cid, install.CID)
+		require.Equal(t, []peer.ID{workers[0]}, install.Succeeded)
+		require.Equal(t, []bls.FunctionInstallFailure{{Peer: workers[1], Error: "could not download function"}}, install.Failed)
+		require.Equal(t, []peer.ID{workers[2]}, install.NoAnswer)
+	})
+	t.Run("collection stops once all workers answer", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, workers)
+		head.cfg.InstallTimeout = time.Minute
+
+		start := time.Now()
+
+		install, err := head.InstallFunction(context.Background(), "", cid, "")
+		require.NoError(t, err)
+
+		require.Less(t, time.Since(start), head.cfg.InstallTimeout)
+		require.Len(t, install.Succeeded, 2)
+		require.Len(t, install.Failed, 1)
+		require.Empty(t, install.NoAnswer)
+	})
+	t.Run("workers not known before are reported once they answer", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, workers[:2])
+		head.workers.Delete(workers[0])
+
+		install, err := head.InstallFunction(context.Background(), "", cid, "")
+		require.NoError(t, err)
+
+		require.Equal(t, []peer.ID{workers[0]}, install.Succeeded)
+		require.Len(t, install.Failed, 1)
+		require.Equal(t, []peer.ID{workers[2]}, install.NoAnswer)
+
+		_, ok := head.workers.Get(workers[0])
+		require.True(t, ok)
+	})
+	t.Run("async install", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, workers[:2])
+
+		id, err := head.InstallFunctionAsync(context.Background(), "", cid, "")
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			install, ok := head.FunctionInstallResult(context.Background(), id)
+			require.True(t, ok)
+			return install.Done
+		}, time.Second, 10*time.Millisecond)
+
+		install, _ := head.FunctionInstallResult(context.Background(), id)
+		require.Equal(t, id, install.RequestID)
+		require.Equal(t, []peer.ID{workers[0]}, install.Succeeded)
+		require.Len(t, install.Failed, 1)
+		require.Equal(t, []peer.ID{workers[2]}, install.NoAnswer)
+
+		// Outcome is kept for a limited time.
+		head.purgeInstallResults(time.Now())
+		_, ok := head.FunctionInstallResult(context.Background(), id)
+		require.True(t, ok)
+
+		head.purgeInstallResults(time.Now().Add(2 * functionInstallResultTTL))
+		_, ok = head.FunctionInstallResult(context.Background(), id)
+		require.False(t, ok)
+	})
+}
+
+func TestHead_UninstallFunction(t *testing.T) {
+
+	const (
+		cid = "dummy-cid"
+	)
+
+	var (
+		workers = mocks.GenericPeerIDs[:3]
+	)
+
+	head := createHeadNode(t)
+	head.cfg.InstallTimeout = 100 * time.Millisecond
+
+	for _, worker := range workers {
+		head.markWorker(worker)
+	}
+
+	// First worker uninstalls the function, the second one is executing it and the third one does not respond.
+	core := mocks.BaselineNodeCore(t)
+	core.TopicPeersFunc = func(string) []peer.ID {
+		return workers
+	}
+	core.PublishToTopicFunc = func(ctx context.Context, _ string, msg bls.Message) error {
+
+		req, ok := any(msg).(*request.UninstallFunction)
+		require.True(t, ok)
+		require.NotEmpty(t, req.RequestID)
+		require.Equal(t, cid, req.CID)
+
+		err := head.processUninstallFunctionResponse(ctx, workers[0], *req.Response(codes.OK, "uninstalled"))
+		require.NoError(t, err)
+
+		err = head.processUninstallFunctionResponse(ctx, workers[1], *req.Response(codes.NotAvailable, "function is being executed"))
+		require.NoError(t, err)
+
+		return nil
+	}
+	head.Core = core
+
+	uninstall, err := head.UninstallFunction(context.Background(), cid, "")
+	require.NoError(t, err)
+
+	require.NotEmpty(t, uninstall.RequestID)
+	require.Equal(t, cid, uninstall.CID)
+	require.Equal(t, []peer.ID{workers[0]}, uninstall.Succeeded)
+	require.Equal(t, []bls.FunctionInstallFailure{{Peer: workers[1], Error: "function is being executed"}}, uninstall.Failed)
+	require.Equal(t, []peer.ID{workers[2]}, uninstall.NoAnswer)
+}
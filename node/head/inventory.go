@@ -0,0 +1,88 @@
+package head
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+)
+
+// peerInventory is the function inventory reported by a peer.
+type peerInventory struct {
+	From peer.ID
+	response.FunctionInventory
+}
+
+// FunctionInventory asks the worker nodes on the topic to report the functions they have installed.
+// Reports are collected for a limited amount of time. Returns the installed functions, mapped to the peers that reported them.
+func (h *HeadNode) FunctionInventory(ctx context.Context, subgroup string) (map[peer.ID][]bls.InstalledFunction, error) {
+
+	requestID := newRequestID()
+	subgroup = cmp.Or(subgroup, bls.DefaultTopic)
+
+	log := h.Log().With().Str("request", requestID).Str("topic", subgroup).Logger()
+
+	reports := make(chan peerInventory, functionInventoryBufferSize)
+	h.inventory.Set(requestID, reports)
+	defer h.inventory.Delete(requestID)
+
+	err := h.PublishToTopic(ctx, subgroup, &request.FunctionInventory{RequestID: requestID})
+	if err != nil {
+		return nil, fmt.Errorf("could not publish function inventory request: %w", err)
+	}
+
+	log.Debug().Msg("function inventory request published")
+
+	tctx, cancel := context.WithTimeout(ctx, h.cfg.InventoryTimeout)
+	defer cancel()
+
+	inventory := make(map[peer.ID][]bls.InstalledFunction)
+	for {
+		select {
+		case report := <-reports:
+
+			if report.Code != codes.OK {
+				log.Warn().Stringer("peer", report.From).Stringer("code", report.Code).Msg("peer could not report its installed functions")
+				continue
+			}
+
+			inventory[report.From] = report.Functions
+
+		case <-tctx.Done():
+
+			log.Info().Int("peers", len(inventory)).Msg("function inventory collected")
+			return inventory, nil
+		}
+	}
+}
+
+// processFunctionInventoryResponse records the function inventory reported by a peer.
+func (h *HeadNode) processFunctionInventoryResponse(ctx context.Context, from peer.ID, res response.FunctionInventory) error {
+
+	h.Log().Debug().
+		Stringer("from", from).
+		Str("request", res.RequestID).
+		Stringer("code", res.Code).
+		Msg("received function inventory response")
+
+	h.markWorker(from)
+
+	reports, ok := h.inventory.Get(res.RequestID)
+	if !ok {
+		return nil
+	}
+
+	select {
+	case reports <- peerInventory{From: from, FunctionInventory: res}:
+	default:
+		return fmt.Errorf("function inventory buffer full, dropping response (request: %s)", res.RequestID)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_FunctionInventory(t *testing.T) {
+
+	var (
+		installed = mocks.GenericFunctionRecord.Installed()
+		workers   = mocks.GenericPeerIDs[:3]
+	)
+
+	head := createHeadNode(t)
+	head.cfg.InventoryTimeout = 100 * time.Millisecond
+
+	var topic string
+	core := mocks.BaselineNodeCore(t)
+	core.PublishToTopicFunc = func(ctx context.Context, subgroup string, msg bls.Message) error {
+
+		req, ok := any(msg).(*request.FunctionInventory)
+		require.True(t, ok)
+
+		topic = subgroup
+
+		// First two workers report their functions, the third one fails.
+		for i, worker := range workers {
+
+			code := codes.OK
+			if i == 2 {
+				code = codes.Error
+			}
+
+			functions := []bls.InstalledFunction{installed}
+			if code != codes.OK {
+				functions = nil
+			}
+
+			err := head.processFunctionInventoryResponse(ctx, worker, *req.Response(code, functions))
+			require.NoError(t, err)
+		}
+
+		return nil
+	}
+	head.Core = core
+
+	inventory, err := head.FunctionInventory(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, bls.DefaultTopic, topic)
+
+	require.Equal(t,
+		map[peer.ID][]bls.InstalledFunction{
+			workers[0]: {installed},
+			workers[1]: {installed},
+		},
+		inventory,
+	)
+
+	// Late responses are ignored.
+	err = head.processFunctionInventoryResponse(context.Background(), workers[2], response.FunctionInventory{RequestID: "unknown", Code: codes.OK})
+	require.NoError(t, err)
+}
@@ -4,19 +4,62 @@ import (
 	"time"
 
 	"github.com/Maelkum/b7s/consensus"
+	"github.com/Maelkum/b7s/models/execute"
 )
 
 const (
 	DefaultRollCallTimeout         = 5 * time.Second
 	DefaultExecutionTimeout        = 20 * time.Second
 	DefaultClusterFormationTimeout = 10 * time.Second
+	DefaultInventoryTimeout        = 2 * time.Second
+	DefaultInstallTimeout          = 30 * time.Second
 	DefaultConsensusAlgorithm      = consensus.Raft
+	DefaultExecutionResultTTL      = 24 * time.Hour
+	DefaultExecutionResultLimit    = 10_000
+	DefaultSelectionStrategy       = execute.SelectionFirstCome
+	DefaultSelectionWindow         = 1 * time.Second
+	DefaultExecutionQueueSize      = 100
+	DefaultReputationPolicy        = ReputationDeprioritize
+	DefaultReputationHalfLife      = 24 * time.Hour
+	DefaultIdempotencyKeyTTL       = 24 * time.Hour
+	DefaultResultCacheTTL          = 10 * time.Minute
+	DefaultResultCacheSize         = 1000
 
 	rollCallQueueBufferSize  = 1000
 	executionResultCacheSize = 1000
+	cancelResponseCacheSize  = 1000
 
 	defaultExecutionThreshold = 0.6
 
+	// How often do we check for expired execution results.
+	resultPurgeInterval = 1 * time.Minute
+
+	// How often do we check for scheduled executions that are due.
+	scheduleCheckInterval = 1 * time.Second
+	// Number of most recent runs kept in the schedule history.
+	scheduleHistorySize = 20
+
 	// Timeout for the context used for sending disband request to cluster nodes.
 	consensusClusterSendTimeout = 10 * time.Second
+
+	// How long do we wait for peers to confirm execution cancellation.
+	cancelResponseTimeout = 5 * time.Second
+	// Maximum number of function inventory reports we buffer per request.
+	functionInventoryBufferSize = 1000
+	// Maximum number of function install responses we buffer per request.
+	functionInstallBufferSize = 1000
+	// How long do we keep outcomes of function installs started asynchronously.
+	functionInstallResultTTL = 1 * time.Hour
+
+	// Maximum number of times a batch item is tried before it is reported as failed.
+	batchItemMaxAttempts = 3
+	// Number of consecutive batch item failures after which a peer stops taking batch items.
+	batchPeerMaxFailures = 3
+
+	// Suffix of topics that head nodes use to share execution request state.
+	stateTopicSuffix = "/heads"
+	// How often do we check if there are head nodes to share the state of orphaned executions with.
+	orphanedShareInterval = 1 * time.Second
+	// How long do we try to share the state of orphaned executions.
+	orphanedShareTimeout = 1 * time.Minute
 )
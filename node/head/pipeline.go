@@ -0,0 +1,104 @@
+package head
+
+import (
+	"context"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/node/aggregate"
+)
+
+// ExecutePipeline runs the pipeline steps in order. Output of a step is passed to the next one as described by the step input mapping.
+// Pipeline stops at the first failed step, unless the step is optional. Returned results are ordered as the pipeline steps.
+func (h *HeadNode) ExecutePipeline(ctx context.Context, pipeline execute.Pipeline, subgroup string) (codes.Code, []execute.PipelineStepResult, error) {
+
+	var (
+		steps = make([]execute.PipelineStepResult, 0, len(pipeline))
+		// Output of the most recent successful step.
+		output string
+	)
+
+	for i, step := range pipeline {
+
+		log := h.Log().With().Int("step", i).Str("function", step.FunctionID).Logger()
+
+		ticket, err := h.admission.reserve()
+		if err != nil {
+			// Reject the pipeline if we could not even start it.
+			if i == 0 {
+				return codes.TooManyRequests, nil, err
+			}
+
+			steps = append(steps, execute.PipelineStepResult{Code: codes.TooManyRequests, Message: err.Error()})
+			if step.Optional {
+				continue
+			}
+
+			return codes.TooManyRequests, steps, nil
+		}
+
+		req := request.Execute{
+			Request: step.Input.Apply(step.Request, output),
+			Topic:   subgroup,
+		}
+
+		requestID := newRequestID()
+
+		code, results, cluster, err := h.executeAndSave(ctx, requestID, req, ticket)
+		if err != nil {
+			log.Error().Str("request", requestID).Err(err).Msg("pipeline step execution failed")
+		}
+
+		steps = append(steps, execute.PipelineStepResult{
+			RequestID: requestID,
+			Code:      code,
+			Results:   results,
+			Cluster:   cluster,
+			Message:   bls.FailureMessage(err),
+		})
+
+		out, ok := stepOutput(code, results, step.Config.ResultAggregation)
+		if ok {
+			output = out
+			continue
+		}
+
+		if step.Optional {
+			log.Info().Str("request", requestID).Msg("optional pipeline step failed, continuing")
+			continue
+		}
+
+		log.Warn().Str("request", requestID).Stringer("code", code).Msg("pipeline step failed, stopping pipeline")
+
+		if code == codes.OK {
+			code = codes.Error
+		}
+
+		return code, steps, nil
+	}
+
+	return codes.OK, steps, nil
+}
+
+// stepOutput returns the output of a pipeline step - standard output of the most common execution result, aggregated as requested by the step.
+// Step is successful if the execution succeeded and the most common result has a zero exit code.
+func stepOutput(code codes.Code, results execute.ResultMap, aggregation execute.ResultAggregation) (string, bool) {
+
+	if code != codes.OK {
+		return "", false
+	}
+
+	aggregated := aggregate.Aggregate(results, aggregation)
+	if len(aggregated) == 0 {
+		return "", false
+	}
+
+	output := aggregated[0].Result
+	if output.ExitCode != 0 {
+		return "", false
+	}
+
+	return output.Stdout, true
+}
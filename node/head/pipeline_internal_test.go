@@ -0,0 +1,165 @@
+package head
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_ExecutePipeline(t *testing.T) {
+
+	const (
+		first  = "first-function"
+		second = "second-function"
+		third  = "third-function"
+	)
+
+	// Outputs of the executed functions. Functions without an output fail.
+	outputs := map[string]string{
+		first: "first-output",
+		third: "third-output",
+	}
+
+	createStep := func(functionID string, input execute.InputMapping, optional bool) execute.PipelineStep {
+		req := mocks.GenericExecutionRequest
+		req.FunctionID = functionID
+
+		return execute.PipelineStep{
+			Request:  req,
+			Input:    input,
+			Optional: optional,
+		}
+	}
+
+	// Setup a head node that executes functions on a single worker, and records the requests workers received.
+	setupHead := func(t *testing.T) (*HeadNode, func() []execute.Request) {
+		t.Helper()
+
+		var (
+			lock     sync.Mutex
+			executed []execute.Request
+		)
+
+		workers, keys := newWorkers(t, 1)
+		worker := workers[0]
+
+		head := createHeadNode(t)
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			head.rollCall.add(rc.RequestID, rollCallResponse{
+				From: worker,
+				RollCall: response.RollCall{
+					Code:       codes.Accepted,
+					FunctionID: rc.FunctionID,
+					RequestID:  rc.RequestID,
+				},
+			})
+
+			return nil
+		}
+		core.SendToManyFunc = func(_ context.Context, _ []peer.ID, msg bls.Message, _ bool) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			lock.Lock()
+			executed = append(executed, wo.Request)
+			lock.Unlock()
+
+			output := execute.RuntimeOutput{ExitCode: 1}
+			stdout, ok := outputs[wo.Request.FunctionID]
+			if ok {
+				output = execute.RuntimeOutput{Stdout: stdout}
+			}
+
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, worker), signedResult(t, keys[worker], wo.RequestID, execute.NodeResult{
+				Result: execute.Result{Code: codes.OK, Result: output},
+			}))
+
+			return nil
+		}
+		head.Core = core
+
+		requests := func() []execute.Request {
+			lock.Lock()
+			defer lock.Unlock()
+
+			return executed
+		}
+
+		return head, requests
+	}
+
+	t.Run("output is passed to the next step", func(t *testing.T) {
+		t.Parallel()
+
+		head, executed := setupHead(t)
+
+		pipeline := execute.Pipeline{
+			createStep(first, execute.InputMapping{}, false),
+			createStep(second, execute.InputMapping{Type: execute.InputStdin}, true),
+			createStep(third, execute.InputMapping{Type: execute.InputEnv, Name: "INPUT"}, false),
+		}
+
+		code, steps, err := head.ExecutePipeline(context.Background(), pipeline, "")
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+		require.Len(t, steps, 3)
+
+		requests := executed()
+		require.Len(t, requests, 3)
+
+		// Second step got the output of the first one on stdin.
+		require.NotNil(t, requests[1].Config.Stdin)
+		require.Equal(t, outputs[first], *requests[1].Config.Stdin)
+
+		// Second step failed, but it's optional - third step got the output of the first one.
+		require.Contains(t, requests[2].Config.Environment, execute.EnvVar{Name: "INPUT", Value: outputs[first]})
+
+		for i, step := range steps {
+			require.NotEmpty(t, step.RequestID)
+			require.Equal(t, codes.OK, step.Code, "step %d", i)
+			require.Len(t, step.Results, 1)
+		}
+	})
+	t.Run("pipeline stops at failed step", func(t *testing.T) {
+		t.Parallel()
+
+		head, executed := setupHead(t)
+
+		pipeline := execute.Pipeline{
+			createStep(first, execute.InputMapping{}, false),
+			createStep(second, execute.InputMapping{Type: execute.InputParameter, Name: "--input"}, false),
+			createStep(third, execute.InputMapping{Type: execute.InputStdin}, false),
+		}
+
+		code, steps, err := head.ExecutePipeline(context.Background(), pipeline, "")
+		require.NoError(t, err)
+		require.Equal(t, codes.Error, code)
+		require.Len(t, steps, 2)
+
+		requests := executed()
+		require.Len(t, requests, 2)
+
+		params := requests[1].Parameters
+		require.Equal(t, []execute.Parameter{{Value: "--input"}, {Value: outputs[first]}}, params[len(params)-2:])
+	})
+}
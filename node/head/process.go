@@ -18,6 +18,8 @@ func (h *HeadNode) process(ctx context.Context, from peer.ID, msg string, payloa
 		return node.HandleMessage(ctx, from, payload, h.processHealthCheck)
 	case bls.MessageInstallFunctionResponse:
 		return node.HandleMessage(ctx, from, payload, h.processInstallFunctionResponse)
+	case bls.MessageUninstallFunctionResponse:
+		return node.HandleMessage(ctx, from, payload, h.processUninstallFunctionResponse)
 	case bls.MessageExecute:
 		return node.HandleMessage(ctx, from, payload, h.processExecute)
 	case bls.MessageRollCallResponse:
@@ -26,6 +28,12 @@ func (h *HeadNode) process(ctx context.Context, from peer.ID, msg string, payloa
 		return node.HandleMessage(ctx, from, payload, h.processWorkOrderResponse)
 	case bls.MessageFormClusterResponse:
 		return node.HandleMessage(ctx, from, payload, h.processFormClusterResponse)
+	case bls.MessageCancelExecutionResponse:
+		return node.HandleMessage(ctx, from, payload, h.processCancelExecutionResponse)
+	case bls.MessageExecutionState:
+		return node.HandleMessage(ctx, from, payload, h.processExecutionState)
+	case bls.MessageFunctionInventoryResponse:
+		return node.HandleMessage(ctx, from, payload, h.processFunctionInventoryResponse)
 	}
 
 	return fmt.Errorf("unsupported message: %s", msg)
@@ -0,0 +1,51 @@
+package head
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// requestState describes an execution request in progress.
+type requestState struct {
+	status execute.Status
+	peers  []peer.ID               // Peers chosen to execute the request.
+	items  map[string]peer.ID      // Batch items in progress - request ID of the item and the peer executing it.
+	cancel context.CancelCauseFunc // Stops the execution on the head node.
+}
+
+// updateRequest modifies the state of an in-progress execution request.
+func (h *HeadNode) updateRequest(requestID string, update func(*requestState)) {
+	h.requests.WithLock(func(requests map[string]requestState) {
+		state := requests[requestID]
+		update(&state)
+		requests[requestID] = state
+	})
+}
+
+// setStatus records the stage the execution request is in.
+func (h *HeadNode) setStatus(requestID string, status execute.Status) {
+
+	h.updateRequest(requestID, func(state *requestState) {
+		state.status = status
+	})
+
+	h.reportProgress(execute.Event{
+		Type:      execute.EventStatus,
+		RequestID: requestID,
+		Status:    status,
+	})
+}
+
+// reportProgress notifies the subscriber, if any, of the progress made on an execution request.
+func (h *HeadNode) reportProgress(event execute.Event) {
+
+	notify, ok := h.progress.Get(event.RequestID)
+	if !ok {
+		return
+	}
+
+	notify(event)
+}
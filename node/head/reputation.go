@@ -0,0 +1,211 @@
+package head
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/node/aggregate"
+)
+
+// Policies for handling roll call responses from peers with low reputation.
+const (
+	ReputationIgnore       = "ignore"       // Peers with low reputation are never chosen.
+	ReputationDeprioritize = "deprioritize" // Peers with low reputation are chosen only if there are not enough other peers.
+)
+
+// outcome describes how a worker performed on a single execution.
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota + 1
+	outcomeFailure
+	outcomeTimeout
+	outcomeNoResult
+	outcomeDisagreement
+	outcomeInvalidSignature
+)
+
+// reputations keeps track of how well worker nodes performed on past executions. Reputations are persisted so they survive node restarts.
+type reputations struct {
+	log      zerolog.Logger
+	store    bls.ReputationStore
+	halfLife time.Duration
+
+	lock  sync.Mutex
+	peers map[peer.ID]bls.Reputation
+}
+
+func newReputations(log zerolog.Logger, store bls.ReputationStore, halfLife time.Duration) *reputations {
+
+	r := reputations{
+		log:      log,
+		store:    store,
+		halfLife: halfLife,
+		peers:    make(map[peer.ID]bls.Reputation),
+	}
+
+	return &r
+}
+
+// load reads the persisted reputations.
+func (r *reputations) load(ctx context.Context) error {
+
+	reputations, err := r.store.RetrieveReputations(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve reputations: %w", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, reputation := range reputations {
+		r.peers[reputation.Peer] = reputation
+	}
+
+	return nil
+}
+
+// record updates the reputation of the peer with the outcome of an execution. Latency is recorded if set.
+func (r *reputations) record(ctx context.Context, id peer.ID, outcome outcome, latency time.Duration) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+
+	reputation := r.peers[id].Decay(now, r.halfLife)
+	reputation.Peer = id
+	reputation.UpdatedAt = now
+
+	switch outcome {
+	case outcomeSuccess:
+		reputation.Successes++
+	case outcomeFailure:
+		reputation.Failures++
+	case outcomeTimeout:
+		reputation.Timeouts++
+	case outcomeNoResult:
+		reputation.NoResults++
+	case outcomeDisagreement:
+		reputation.Disagreements++
+	case outcomeInvalidSignature:
+		reputation.InvalidSignatures++
+	}
+
+	if latency > 0 {
+		reputation.RecordLatency(latency)
+	}
+
+	r.peers[id] = reputation
+
+	err := r.store.SaveReputation(ctx, reputation)
+	if err != nil {
+		r.log.Error().Err(err).Stringer("peer", id).Msg("could not save reputation")
+	}
+}
+
+// score returns the current reputation score of the peer. Peers we know nothing about have the highest score.
+func (r *reputations) score(id peer.ID) float64 {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	reputation, ok := r.peers[id]
+	if !ok {
+		return 1
+	}
+
+	return reputation.Decay(time.Now(), r.halfLife).Score()
+}
+
+// list returns the current reputations of all known peers, highest score first.
+func (r *reputations) list() []bls.Reputation {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+
+	out := make([]bls.Reputation, 0, len(r.peers))
+	for _, reputation := range r.peers {
+		out = append(out, reputation.Decay(now, r.halfLife))
+	}
+
+	slices.SortFunc(out, func(a, b bls.Reputation) int {
+		return cmp.Or(
+			cmp.Compare(b.Score(), a.Score()),
+			cmp.Compare(a.Peer, b.Peer),
+		)
+	})
+
+	return out
+}
+
+// lowReputation reports whether the roll call response from the peer should be ignored or deprioritized because of its reputation.
+func (h *HeadNode) lowReputation(id peer.ID) bool {
+	return h.cfg.ReputationThreshold > 0 && h.reputation.score(id) < h.cfg.ReputationThreshold
+}
+
+// recordResult updates the reputation of the peer based on the execution result it sent.
+func (h *HeadNode) recordResult(ctx context.Context, id peer.ID, res execute.NodeResult, latency time.Duration) {
+
+	switch res.Code {
+	case codes.OK:
+		h.reputation.record(ctx, id, outcomeSuccess, latency)
+	case codes.Timeout:
+		h.reputation.record(ctx, id, outcomeTimeout, latency)
+	default:
+		h.reputation.record(ctx, id, outcomeFailure, latency)
+	}
+}
+
+// recordDisagreements updates the reputation of peers whose results differ from the majority result.
+// If there is no majority result, no peer is penalized.
+func (h *HeadNode) recordDisagreements(ctx context.Context, results execute.ResultMap, aggregation execute.ResultAggregation) {
+
+	aggregated := aggregate.Aggregate(results, comparisonAggregation(aggregation))
+	if len(aggregated) < 2 || aggregated[0].Frequency <= 50 {
+		return
+	}
+
+	for _, group := range aggregated[1:] {
+		for _, id := range group.Peers {
+			h.reputation.record(ctx, id, outcomeDisagreement, 0)
+		}
+	}
+}
+
+// withDeprioritized adds peers with low reputation to the roll call candidates, if there are not enough other candidates.
+// If any number of peers will do (-1), peers with low reputation are used only if no other peer reported.
+func (h *HeadNode) withDeprioritized(candidates []Candidate, deprioritized []Candidate, nodeCount int) []Candidate {
+
+	need := nodeCount - len(candidates)
+	if nodeCount == -1 {
+		need = len(deprioritized)
+		if len(candidates) > 0 {
+			need = 0
+		}
+	}
+
+	if need <= 0 || len(deprioritized) == 0 {
+		return candidates
+	}
+
+	// Prefer the peers with the better reputation.
+	deprioritized = slices.Clone(deprioritized)
+	slices.SortStableFunc(deprioritized, func(a, b Candidate) int {
+		return cmp.Compare(h.reputation.score(b.ID), h.reputation.score(a.ID))
+	})
+
+	return append(candidates, deprioritized[:min(need, len(deprioritized))]...)
+}
@@ -0,0 +1,208 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/store"
+	"github.com/Maelkum/b7s/store/codec"
+	"github.com/Maelkum/b7s/testing/helpers"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_Reputation(t *testing.T) {
+
+	var (
+		ctx  = context.Background()
+		good = mocks.GenericPeerIDs[0]
+		bad  = mocks.GenericPeerIDs[1]
+	)
+
+	t.Run("outcomes are recorded and persisted", func(t *testing.T) {
+		t.Parallel()
+
+		db := store.New(helpers.InMemoryDB(t), codec.NewJSONCodec())
+
+		reputation := newReputations(mocks.NoopLogger, db, time.Hour)
+		reputation.record(ctx, good, outcomeSuccess, 100*time.Millisecond)
+		reputation.record(ctx, good, outcomeSuccess, 200*time.Millisecond)
+		reputation.record(ctx, bad, outcomeNoResult, 0)
+		reputation.record(ctx, bad, outcomeInvalidSignature, 0)
+
+		require.Equal(t, float64(1), reputation.score(good))
+		require.Less(t, reputation.score(bad), 0.5)
+		require.Equal(t, float64(1), reputation.score(mocks.GenericPeerIDs[2]))
+
+		list := reputation.list()
+		require.Len(t, list, 2)
+		require.Equal(t, good, list[0].Peer)
+		require.InDelta(t, 2, list[0].Successes, 0.001)
+		require.Equal(t, 120*time.Millisecond, list[0].Latency)
+
+		// Reputations survive a restart.
+		loaded := newReputations(mocks.NoopLogger, db, time.Hour)
+		require.NoError(t, loaded.load(ctx))
+		require.InDelta(t, reputation.score(bad), loaded.score(bad), 0.001)
+	})
+	t.Run("counters decay over time", func(t *testing.T) {
+		t.Parallel()
+
+		reputation := bls.Reputation{
+			Peer:      bad,
+			Successes: 4,
+			Failures:  8,
+			UpdatedAt: time.Now().Add(-2 * time.Hour),
+		}
+
+		decayed := reputation.Decay(time.Now(), time.Hour)
+		require.InDelta(t, 1, decayed.Successes, 0.01)
+		require.InDelta(t, 2, decayed.Failures, 0.01)
+		require.Greater(t, decayed.Score(), reputation.Score())
+	})
+	t.Run("peers disagreeing with the majority are penalized", func(t *testing.T) {
+		t.Parallel()
+
+		head := createHeadNode(t)
+
+		var (
+			majority = execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "majority"}}
+			minority = execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "minority"}}
+		)
+
+		results := execute.ResultMap{
+			mocks.GenericPeerIDs[0]: {Result: majority},
+			mocks.GenericPeerIDs[1]: {Result: majority},
+			mocks.GenericPeerIDs[2]: {Result: minority},
+		}
+
+		head.recordDisagreements(ctx, results, execute.ResultAggregation{})
+
+		list := head.reputation.list()
+		require.Len(t, list, 1)
+		require.Equal(t, mocks.GenericPeerIDs[2], list[0].Peer)
+		require.InDelta(t, 1, list[0].Disagreements, 0.001)
+	})
+	t.Run("disagreements are found with numeric aggregation", func(t *testing.T) {
+		t.Parallel()
+
+		head := createHeadNode(t)
+
+		results := execute.ResultMap{
+			mocks.GenericPeerIDs[0]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "10"}}},
+			mocks.GenericPeerIDs[1]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "10"}}},
+			mocks.GenericPeerIDs[2]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "1000"}}},
+		}
+
+		head.recordDisagreements(ctx, results, execute.ResultAggregation{Enable: true, Type: execute.AggregationMedian})
+
+		list := head.reputation.list()
+		require.Len(t, list, 1)
+		require.Equal(t, mocks.GenericPeerIDs[2], list[0].Peer)
+	})
+	t.Run("no majority means no disagreement", func(t *testing.T) {
+		t.Parallel()
+
+		head := createHeadNode(t)
+
+		results := execute.ResultMap{
+			mocks.GenericPeerIDs[0]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "first"}}},
+			mocks.GenericPeerIDs[1]: {Result: execute.Result{Result: execute.RuntimeOutput{Stdout: "second"}}},
+		}
+
+		head.recordDisagreements(ctx, results, execute.ResultAggregation{})
+		require.Empty(t, head.reputation.list())
+	})
+}
+
+func TestHead_RollCall_Reputation(t *testing.T) {
+
+	var (
+		good = mocks.GenericPeerIDs[0]
+		bad  = mocks.GenericPeerIDs[1]
+	)
+
+	// Setup a head node where both peers respond to roll calls, and the bad peer has a poor reputation.
+	setupHead := func(t *testing.T, options ...Option) *HeadNode {
+		t.Helper()
+
+		options = append([]Option{ReputationThreshold(0.5)}, options...)
+
+		head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t), options...)
+		require.NoError(t, err)
+
+		for range 5 {
+			head.reputation.record(context.Background(), bad, outcomeNoResult, 0)
+		}
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			for _, peer := range []peer.ID{bad, good} {
+				head.rollCall.add(rc.RequestID, rollCallResponse{
+					From: peer,
+					RollCall: response.RollCall{
+						Code:       codes.Accepted,
+						FunctionID: rc.FunctionID,
+						RequestID:  rc.RequestID,
+					},
+				})
+			}
+
+			return nil
+		}
+		head.Core = core
+
+		return head
+	}
+
+	createRequest := func(nodeCount int) request.Execute {
+
+		req := mocks.GenericExecutionRequest
+		req.Config.NodeCount = nodeCount
+		req.Config.Timeout = 1
+
+		return request.Execute{Request: req}
+	}
+
+	t.Run("low reputation peers are deprioritized", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t)
+
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(1), 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, []peer.ID{good}, cluster.Peers)
+	})
+	t.Run("low reputation peers are used if there are not enough other peers", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t)
+
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(2), 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, []peer.ID{good, bad}, cluster.Peers)
+	})
+	t.Run("low reputation peers are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		head := setupHead(t, ReputationPolicy(ReputationIgnore))
+
+		_, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(2), 0, nil)
+		require.ErrorIs(t, err, bls.ErrRollCallTimeout)
+	})
+}
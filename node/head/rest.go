@@ -3,6 +3,7 @@ package head
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 
 	"github.com/Maelkum/b7s/models/bls"
@@ -14,9 +15,14 @@ import (
 // ExecuteFunction can be used to start function execution. At the moment this is used by the API server to start execution on the head node.
 func (h *HeadNode) ExecuteFunction(ctx context.Context, req execute.Request, subgroup string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
 
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		return codes.TooManyRequests, "", nil, execute.Cluster{}, err
+	}
+
 	requestID := newRequestID()
 
-	code, results, cluster, err := h.execute(ctx, requestID, request.Execute{Request: req})
+	code, results, cluster, err := h.executeAndSave(ctx, requestID, request.Execute{Request: req, Topic: subgroup}, ticket)
 	if err != nil {
 		h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
 	}
@@ -24,24 +30,163 @@ func (h *HeadNode) ExecuteFunction(ctx context.Context, req execute.Request, sub
 	return code, requestID, results, cluster, nil
 }
 
-// ExecutionResult fetches the execution result from the node cache.
-func (h *HeadNode) ExecutionResult(id string) (execute.ResultMap, bool) {
-	// TBD: Head node currently does not cache results.
-	return nil, false
+// ExecuteFunctionWithProgress is like ExecuteFunction, but the given callback is invoked as progress is made on the execution request.
+// The callback may be invoked concurrently.
+func (h *HeadNode) ExecuteFunctionWithProgress(ctx context.Context, req execute.Request, subgroup string, progress func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		return codes.TooManyRequests, "", nil, execute.Cluster{}, err
+	}
+
+	requestID := newRequestID()
+
+	h.progress.Set(requestID, progress)
+	defer h.progress.Delete(requestID)
+
+	code, results, cluster, err := h.executeAndSave(ctx, requestID, request.Execute{Request: req, Topic: subgroup}, ticket)
+	if err != nil {
+		h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
+	}
+
+	return code, requestID, results, cluster, nil
 }
 
-// PublishFunctionInstall publishes a function install message.
-func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error {
+// ExecuteFunctionAsync starts function execution in the background and returns the request ID immediately.
+// Execution status and result can be retrieved using the request ID.
+func (h *HeadNode) ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string) (string, error) {
+
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		return "", err
+	}
+
+	requestID := newRequestID()
+	h.acceptRequest(requestID, ticket)
 
-	var req request.InstallFunction
-	if uri != "" {
-		var err error
-		req, err = createInstallMessageFromURI(uri)
+	// Execution should outlive the API request that started it.
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		_, _, _, err := h.executeAndSave(ctx, requestID, request.Execute{Request: req, Topic: subgroup}, ticket)
 		if err != nil {
-			return fmt.Errorf("could not create install message from URI: %W", err)
+			h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
 		}
-	} else {
-		req = createInstallMessageFromCID(cid)
+	}()
+
+	return requestID, nil
+}
+
+// ExecuteFunctionIdempotent is like ExecuteFunctionWithProgress, but requests with the same idempotency key start a single execution.
+// Repeated requests wait for the execution in progress or return the result of the finished one. Progress is reported only to the request that started the execution.
+// The progress callback is optional.
+func (h *HeadNode) ExecuteFunctionIdempotent(ctx context.Context, key string, req execute.Request, subgroup string, progress func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+
+	entry, err := h.executeIdempotent(ctx, key, request.Execute{Request: req, Topic: subgroup}, progress)
+	if errors.Is(err, bls.ErrTooManyRequests) {
+		return codes.TooManyRequests, "", nil, execute.Cluster{}, err
+	}
+	if err != nil {
+		return codes.Invalid, "", nil, execute.Cluster{}, err
+	}
+
+	select {
+	case <-entry.done:
+	case <-ctx.Done():
+		return codes.Error, entry.requestID, nil, execute.Cluster{}, ctx.Err()
+	}
+
+	return entry.code, entry.requestID, entry.results, entry.cluster, entry.err
+}
+
+// ExecuteFunctionAsyncIdempotent is like ExecuteFunctionAsync, but requests with the same idempotency key start a single execution.
+// Repeated requests get the request ID of the existing execution.
+func (h *HeadNode) ExecuteFunctionAsyncIdempotent(ctx context.Context, key string, req execute.Request, subgroup string) (string, error) {
+
+	entry, err := h.executeIdempotent(ctx, key, request.Execute{Request: req, Topic: subgroup}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// Existing execution might have been rejected in the meantime.
+	select {
+	case <-entry.done:
+		if entry.err != nil {
+			return "", entry.err
+		}
+	default:
+	}
+
+	return entry.requestID, nil
+}
+
+// acceptRequest sets the initial status of the request, so the request is known even before the execution starts.
+func (h *HeadNode) acceptRequest(requestID string, ticket *ticket) {
+
+	status := execute.StatusRollCall
+	if ticket.queued {
+		status = execute.StatusQueued
+	}
+
+	h.setStatus(requestID, status)
+}
+
+// ExecutionStatus returns the status of the execution request. For finished executions the execution record is returned too.
+func (h *HeadNode) ExecutionStatus(ctx context.Context, id string) (execute.Status, bls.ExecutionRecord, bool) {
+
+	state, ok := h.requests.Get(id)
+	if ok {
+		return state.status, bls.ExecutionRecord{}, true
+	}
+
+	record, ok := h.results.get(ctx, id)
+	if !ok {
+		return "", bls.ExecutionRecord{}, false
+	}
+
+	return record.Status, record, true
+}
+
+// ExecutionResult fetches the execution result from the node result store.
+func (h *HeadNode) ExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, bool) {
+
+	record, ok := h.results.get(ctx, id)
+	if !ok {
+		return bls.ExecutionRecord{}, false
+	}
+
+	// Results of batch items are available as they complete.
+	batch, ok := h.batches.Get(id)
+	if ok {
+		state, _ := h.requests.Get(id)
+		record.Status = state.status
+		record.Batch = batch.results()
+		return record, true
+	}
+
+	if !record.Status.Final() {
+		return bls.ExecutionRecord{}, false
+	}
+
+	return record, true
+}
+
+// Reputations returns the reputation of worker nodes, as seen by this head node.
+func (h *HeadNode) Reputations() []bls.Reputation {
+	return h.reputation.list()
+}
+
+// SignResponse signs the execution response using the head node key.
+func (h *HeadNode) SignResponse(res *execute.SignedResponse) error {
+	return res.Sign(h.Host().PrivateKey())
+}
+
+// PublishFunctionInstall publishes a function install message.
+func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error {
+
+	req, err := createInstallMessage(uri, cid)
+	if err != nil {
+		return err
 	}
 
 	if subgroup == "" {
@@ -50,7 +195,7 @@ func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid s
 
 	h.Log().Debug().Str("subgroup", subgroup).Str("url", req.ManifestURL).Str("cid", req.CID).Msg("publishing function install message")
 
-	err := h.PublishToTopic(ctx, subgroup, &req)
+	err = h.PublishToTopic(ctx, subgroup, &req)
 	if err != nil {
 		return fmt.Errorf("could not publish message: %w", err)
 	}
@@ -58,6 +203,86 @@ func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid s
 	return nil
 }
 
+// InstallFunction publishes a function install message and collects the install responses from worker nodes.
+func (h *HeadNode) InstallFunction(ctx context.Context, uri string, cid string, subgroup string) (bls.FunctionInstall, error) {
+
+	req, err := createInstallMessage(uri, cid)
+	if err != nil {
+		return bls.FunctionInstall{}, err
+	}
+	req.RequestID = newRequestID()
+
+	install, err := h.publishFunctionMessage(ctx, req.RequestID, req.CID, &req, subgroup)
+	if err != nil {
+		return bls.FunctionInstall{}, err
+	}
+
+	return install.collect(ctx).install(req.RequestID, req.CID), nil
+}
+
+// InstallFunctionAsync publishes a function install message and returns immediately.
+// Install responses are collected in the background, and their outcome can be retrieved using the returned request ID.
+func (h *HeadNode) InstallFunctionAsync(ctx context.Context, uri string, cid string, subgroup string) (string, error) {
+
+	req, err := createInstallMessage(uri, cid)
+	if err != nil {
+		return "", err
+	}
+
+	requestID := newRequestID()
+	req.RequestID = requestID
+
+	install, err := h.publishFunctionMessage(ctx, requestID, req.CID, &req, subgroup)
+	if err != nil {
+		return "", err
+	}
+
+	h.installResults.Set(requestID, bls.FunctionInstall{RequestID: requestID, CID: req.CID})
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		h.installResults.Set(requestID, install.collect(ctx).install(requestID, req.CID))
+	}()
+
+	return requestID, nil
+}
+
+// FunctionInstallResult returns the outcome of the function install started asynchronously.
+func (h *HeadNode) FunctionInstallResult(_ context.Context, id string) (bls.FunctionInstall, bool) {
+	return h.installResults.Get(id)
+}
+
+// UninstallFunction publishes a function uninstall message and collects the uninstall responses from worker nodes.
+func (h *HeadNode) UninstallFunction(ctx context.Context, cid string, subgroup string) (bls.FunctionUninstall, error) {
+
+	req := request.UninstallFunction{
+		RequestID: newRequestID(),
+		CID:       cid,
+	}
+
+	uninstall, err := h.publishFunctionMessage(ctx, req.RequestID, req.CID, &req, subgroup)
+	if err != nil {
+		return bls.FunctionUninstall{}, err
+	}
+
+	return uninstall.collect(ctx).uninstall(req.RequestID, req.CID), nil
+}
+
+// createInstallMessage creates a MsgInstallFunction from the given URI, if set, or the given CID otherwise.
+func createInstallMessage(uri string, cid string) (request.InstallFunction, error) {
+
+	if uri == "" {
+		return createInstallMessageFromCID(cid), nil
+	}
+
+	req, err := createInstallMessageFromURI(uri)
+	if err != nil {
+		return request.InstallFunction{}, fmt.Errorf("could not create install message from URI: %w", err)
+	}
+
+	return req, nil
+}
+
 // createInstallMessageFromURI creates a MsgInstallFunction from the given URI.
 // CID is calculated as a SHA-256 hash of the URI.
 func createInstallMessageFromURI(uri string) (request.InstallFunction, error) {
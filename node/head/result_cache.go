@@ -0,0 +1,185 @@
+package head
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+)
+
+// resultCache keeps results of cacheable functions, so identical requests can be answered without executing the function again.
+// Entries expire after the configured TTL. Least recently used entries are evicted once the cache is full.
+type resultCache struct {
+	ttl   time.Duration
+	cache *lru.Cache
+}
+
+type resultCacheEntry struct {
+	results execute.ResultMap
+	cluster execute.Cluster
+	expires time.Time
+}
+
+func newResultCache(ttl time.Duration, size uint) *resultCache {
+
+	// Only errors if the size is not positive.
+	cache, _ := lru.New(int(size))
+
+	rc := resultCache{
+		ttl:   ttl,
+		cache: cache,
+	}
+
+	return &rc
+}
+
+// get returns the cached results for the given key, if we have them and they have not yet expired.
+func (c *resultCache) get(key string) (resultCacheEntry, bool) {
+
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return resultCacheEntry{}, false
+	}
+
+	entry := value.(resultCacheEntry)
+	if time.Now().After(entry.expires) {
+		c.cache.Remove(key)
+		return resultCacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+// set caches the results for the given key.
+func (c *resultCache) set(key string, results execute.ResultMap, cluster execute.Cluster) {
+
+	entry := resultCacheEntry{
+		results: maps.Clone(results),
+		cluster: cluster,
+		expires: time.Now().Add(c.ttl),
+	}
+
+	c.cache.Add(key, entry)
+}
+
+// resultCacheKey returns the key identifying identical execution requests - requests for the same function, with the same input,
+// executed the same way - on the same number of peers, from the same topic, with the same consensus, peer and attribute constraints,
+// and with the same thresholds for a successful execution.
+func resultCacheKey(req request.Execute) (string, error) {
+
+	input := struct {
+		FunctionID         string              `json:"function_id"`
+		Method             string              `json:"method"`
+		Parameters         []execute.Parameter `json:"parameters,omitempty"`
+		Environment        []execute.EnvVar    `json:"env_vars,omitempty"`
+		Stdin              *string             `json:"stdin,omitempty"`
+		Topic              string              `json:"topic,omitempty"`
+		NodeCount          int                 `json:"node_count,omitempty"`
+		Consensus          string              `json:"consensus,omitempty"`
+		IncludePeers       []peer.ID           `json:"include_peers,omitempty"`
+		ExcludePeers       []peer.ID           `json:"exclude_peers,omitempty"`
+		Attributes         *execute.Attributes `json:"attributes,omitempty"`
+		Threshold          float64             `json:"threshold,omitempty"`
+		AgreementThreshold float64             `json:"agreement_threshold,omitempty"`
+	}{
+		FunctionID:         req.FunctionID,
+		Method:             req.Method,
+		Parameters:         req.Parameters,
+		Environment:        req.Config.Environment,
+		Stdin:              req.Config.Stdin,
+		Topic:              req.Topic,
+		NodeCount:          req.Config.NodeCount,
+		Consensus:          req.Config.ConsensusAlgorithm,
+		IncludePeers:       sortedPeers(req.Config.IncludePeers),
+		ExcludePeers:       sortedPeers(req.Config.ExcludePeers),
+		Attributes:         req.Config.Attributes,
+		Threshold:          req.Config.Threshold,
+		AgreementThreshold: req.Config.AgreementThreshold,
+	}
+
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("could not encode request: %w", err)
+	}
+
+	hash := sha256.Sum256(payload)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// sortedPeers returns a sorted copy of the peer list, so that the order in which peers are listed doesn't matter.
+func sortedPeers(peers []peer.ID) []peer.ID {
+
+	sorted := slices.Clone(peers)
+	slices.Sort(sorted)
+
+	return sorted
+}
+
+// cacheable reports whether the results can be cached - all peers reported success and marked the function as cacheable.
+func cacheable(results execute.ResultMap) bool {
+
+	if len(results) == 0 {
+		return false
+	}
+
+	for _, res := range results {
+		if res.Code != codes.OK || !res.Cacheable {
+			return false
+		}
+	}
+
+	return true
+}
+
+// executeCached answers the execution request using cached results of an identical request, if we have them.
+// Otherwise the request is executed, and the results are cached if the function is cacheable.
+func (h *HeadNode) executeCached(ctx context.Context, requestID string, req request.Execute) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	log := h.Log().With().Str("request", requestID).Str("function", req.FunctionID).Logger()
+	labels := []metrics.Label{{Name: "function", Value: req.FunctionID}}
+
+	key, err := resultCacheKey(req)
+	if err != nil {
+		log.Warn().Err(err).Msg("could not determine result cache key")
+		return h.execute(ctx, requestID, req)
+	}
+
+	if !req.Config.BypassCache {
+		entry, ok := h.resultCache.get(key)
+		if ok {
+			log.Info().Msg("execution request served from result cache")
+			h.Metrics().IncrCounterWithLabels(resultCacheHitsMetric, 1, labels)
+
+			cluster := entry.cluster
+			cluster.Cached = true
+
+			return codes.OK, maps.Clone(entry.results), cluster, nil
+		}
+	}
+
+	code, results, cluster, err := h.execute(ctx, requestID, req)
+	if err != nil || code != codes.OK || !cacheable(results) {
+		return code, results, cluster, err
+	}
+
+	if !req.Config.BypassCache {
+		h.Metrics().IncrCounterWithLabels(resultCacheMissesMetric, 1, labels)
+	}
+
+	h.resultCache.set(key, results, cluster)
+
+	return code, results, cluster, nil
+}
@@ -0,0 +1,273 @@
+package head
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_ResultCache(t *testing.T) {
+
+	// Setup a head node where a single worker responds to roll calls and executes the function.
+	setupHead := func(t *testing.T, cacheable bool, rollCalls *atomic.Int32, options ...Option) *HeadNode {
+		t.Helper()
+
+		workers, keys := newWorkers(t, 1)
+		worker := workers[0]
+
+		head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t), options...)
+		require.NoError(t, err)
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			rollCalls.Add(1)
+
+			head.rollCall.add(rc.RequestID, rollCallResponse{
+				From: worker,
+				RollCall: response.RollCall{
+					Code:       codes.Accepted,
+					FunctionID: rc.FunctionID,
+					RequestID:  rc.RequestID,
+				},
+			})
+
+			return nil
+		}
+		core.SendToManyFunc = func(_ context.Context, _ []peer.ID, msg bls.Message, _ bool) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			result := mocks.GenericExecutionResult
+			result.Code = codes.OK
+
+			res := signedResult(t, keys[worker], wo.RequestID, execute.NodeResult{Result: result, Cacheable: cacheable})
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, worker), res)
+
+			return nil
+		}
+		head.Core = core
+
+		return head
+	}
+
+	t.Run("repeated request is served from cache", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, true, &rollCalls)
+
+		code, _, results, cluster, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+		require.False(t, cluster.Cached)
+
+		cachedCode, _, cachedResults, cachedCluster, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, cachedCode)
+		require.Equal(t, results, cachedResults)
+		require.True(t, cachedCluster.Cached)
+		require.Equal(t, cluster.Peers, cachedCluster.Peers)
+
+		require.Equal(t, int32(1), rollCalls.Load())
+	})
+	t.Run("request with different input is executed", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, true, &rollCalls)
+
+		_, _, _, _, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.Environment = []execute.EnvVar{{Name: "ENV", Value: "value"}}
+
+		_, _, _, cluster, err := head.ExecuteFunction(context.Background(), req, "")
+		require.NoError(t, err)
+		require.False(t, cluster.Cached)
+
+		require.Equal(t, int32(2), rollCalls.Load())
+	})
+	t.Run("request executed differently is not served from cache", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, true, &rollCalls)
+
+		_, _, _, _, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+
+		// Same function and input, but for a different topic.
+		_, _, _, cluster, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "other-topic")
+		require.NoError(t, err)
+		require.False(t, cluster.Cached)
+
+		// Same function and input, but with peer constraints.
+		req := mocks.GenericExecutionRequest
+		req.Config.ExcludePeers = []peer.ID{mocks.GenericPeerIDs[0]}
+
+		_, _, _, cluster, err = head.ExecuteFunction(context.Background(), req, "")
+		require.NoError(t, err)
+		require.False(t, cluster.Cached)
+
+		require.Equal(t, int32(3), rollCalls.Load())
+	})
+	t.Run("request can bypass the cache", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, true, &rollCalls)
+
+		_, _, _, _, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.BypassCache = true
+
+		_, _, _, cluster, err := head.ExecuteFunction(context.Background(), req, "")
+		require.NoError(t, err)
+		require.False(t, cluster.Cached)
+
+		require.Equal(t, int32(2), rollCalls.Load())
+	})
+	t.Run("results of functions not marked as cacheable are not cached", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, false, &rollCalls)
+
+		for range 2 {
+			_, _, _, cluster, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+			require.NoError(t, err)
+			require.False(t, cluster.Cached)
+		}
+
+		require.Equal(t, int32(2), rollCalls.Load())
+	})
+	t.Run("request with different attributes is not served from cache", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, true, &rollCalls)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.Attributes = &execute.Attributes{
+			Values: []execute.Parameter{{Name: "region", Value: "eu"}},
+		}
+
+		_, _, _, _, err := head.ExecuteFunction(context.Background(), req, "")
+		require.NoError(t, err)
+
+		// Same function and input, but for nodes with different attributes.
+		req.Config.Attributes = &execute.Attributes{
+			Values: []execute.Parameter{{Name: "region", Value: "us"}},
+		}
+
+		_, _, _, cluster, err := head.ExecuteFunction(context.Background(), req, "")
+		require.NoError(t, err)
+		require.False(t, cluster.Cached)
+
+		require.Equal(t, int32(2), rollCalls.Load())
+	})
+	t.Run("cached results expire", func(t *testing.T) {
+		t.Parallel()
+
+		var rollCalls atomic.Int32
+		head := setupHead(t, true, &rollCalls, ResultCacheTTL(time.Millisecond))
+
+		_, _, _, _, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+
+		time.Sleep(5 * time.Millisecond)
+
+		_, _, _, cluster, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+		require.False(t, cluster.Cached)
+
+		require.Equal(t, int32(2), rollCalls.Load())
+	})
+	t.Run("cache key includes execution constraints", func(t *testing.T) {
+		t.Parallel()
+
+		base := request.Execute{Request: mocks.GenericExecutionRequest}
+
+		baseKey, err := resultCacheKey(base)
+		require.NoError(t, err)
+
+		nodeCount := base
+		nodeCount.Config.NodeCount = 3
+
+		consensus := base
+		consensus.Config.ConsensusAlgorithm = "pbft"
+
+		topic := base
+		topic.Topic = "other-topic"
+
+		include := base
+		include.Config.IncludePeers = []peer.ID{mocks.GenericPeerIDs[0]}
+
+		exclude := base
+		exclude.Config.ExcludePeers = []peer.ID{mocks.GenericPeerIDs[0]}
+
+		attributes := base
+		attributes.Config.Attributes = &execute.Attributes{
+			Values: []execute.Parameter{{Name: "region", Value: "eu"}},
+		}
+
+		threshold := base
+		threshold.Config.Threshold = 0.6
+
+		agreement := base
+		agreement.Config.AgreementThreshold = 0.6
+
+		for _, req := range []request.Execute{nodeCount, consensus, topic, include, exclude, attributes, threshold, agreement} {
+			key, err := resultCacheKey(req)
+			require.NoError(t, err)
+			require.NotEqual(t, baseKey, key)
+		}
+
+		// Order in which peers are listed does not matter.
+		first := base
+		first.Config.IncludePeers = []peer.ID{mocks.GenericPeerIDs[0], mocks.GenericPeerIDs[1]}
+		second := base
+		second.Config.IncludePeers = []peer.ID{mocks.GenericPeerIDs[1], mocks.GenericPeerIDs[0]}
+
+		firstKey, err := resultCacheKey(first)
+		require.NoError(t, err)
+		secondKey, err := resultCacheKey(second)
+		require.NoError(t, err)
+		require.Equal(t, firstKey, secondKey)
+	})
+	t.Run("least recently used results are evicted", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newResultCache(time.Hour, 1)
+		cache.set("first", mocks.GenericExecutionResultMap, execute.Cluster{})
+		cache.set("second", mocks.GenericExecutionResultMap, execute.Cluster{})
+
+		_, ok := cache.get("first")
+		require.False(t, ok)
+
+		_, ok = cache.get("second")
+		require.True(t, ok)
+	})
+}
@@ -0,0 +1,201 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+// resultStore keeps records of finished executions. Records are persisted so they survive node restarts.
+// Records older than the configured TTL are dropped, as are the oldest records once the size limit is reached.
+type resultStore struct {
+	log   zerolog.Logger
+	store bls.ExecutionStore
+	ttl   time.Duration
+	limit uint
+
+	lock sync.Mutex
+	// Known records, ordered by completion time - oldest first.
+	index []resultEntry
+}
+
+type resultEntry struct {
+	id        string
+	completed time.Time
+}
+
+func newResultStore(log zerolog.Logger, store bls.ExecutionStore, ttl time.Duration, limit uint) *resultStore {
+
+	rs := resultStore{
+		log:   log,
+		store: store,
+		ttl:   ttl,
+		limit: limit,
+		index: make([]resultEntry, 0),
+	}
+
+	return &rs
+}
+
+// load reads existing execution records from the store. Records of executions that were still in progress are returned.
+func (r *resultStore) load(ctx context.Context) ([]bls.ExecutionRecord, error) {
+
+	records, err := r.store.RetrieveExecutions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve execution records: %w", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var unfinished []bls.ExecutionRecord
+
+	r.index = make([]resultEntry, 0, len(records))
+	for _, record := range records {
+		r.index = append(r.index, resultEntry{id: record.RequestID, completed: recordTime(record)})
+
+		if !record.Status.Final() {
+			unfinished = append(unfinished, record)
+		}
+	}
+
+	sort.SliceStable(r.index, func(i, j int) bool {
+		return r.index[i].completed.Before(r.index[j].completed)
+	})
+
+	r.evict(ctx)
+
+	return unfinished, nil
+}
+
+// save persists the execution record.
+func (r *resultStore) save(ctx context.Context, record bls.ExecutionRecord) error {
+
+	err := r.store.SaveExecution(ctx, record)
+	if err != nil {
+		return fmt.Errorf("could not save execution record: %w", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	entry := resultEntry{id: record.RequestID, completed: recordTime(record)}
+
+	// Drop the existing entry if we're updating the record.
+	r.index = slices.DeleteFunc(r.index, func(e resultEntry) bool {
+		return e.id == entry.id
+	})
+
+	// Executions typically complete in order, but keep the index sorted in any case.
+	idx := sort.Search(len(r.index), func(i int) bool {
+		return r.index[i].completed.After(entry.completed)
+	})
+	r.index = append(r.index, resultEntry{})
+	copy(r.index[idx+1:], r.index[idx:])
+	r.index[idx] = entry
+
+	r.evict(ctx)
+
+	return nil
+}
+
+// get retrieves the execution record for the given request, if we have it and it has not yet expired.
+func (r *resultStore) get(ctx context.Context, id string) (bls.ExecutionRecord, bool) {
+
+	record, err := r.store.RetrieveExecution(ctx, id)
+	if err != nil {
+		if !errors.Is(err, bls.ErrNotFound) {
+			r.log.Warn().Err(err).Str("request", id).Msg("could not retrieve execution record")
+		}
+		return bls.ExecutionRecord{}, false
+	}
+
+	if r.expired(recordTime(record)) {
+		return bls.ExecutionRecord{}, false
+	}
+
+	return record, true
+}
+
+// purge removes expired execution records.
+func (r *resultStore) purge(ctx context.Context) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.evict(ctx)
+}
+
+// evict removes expired records and, if we're still over the size limit, the oldest records.
+// NOTE: Must be called with the lock held.
+func (r *resultStore) evict(ctx context.Context) {
+
+	n := 0
+	for n < len(r.index) {
+
+		entry := r.index[n]
+		if !r.expired(entry.completed) && uint(len(r.index)-n) <= r.limit {
+			break
+		}
+
+		err := r.store.RemoveExecution(ctx, entry.id)
+		if err != nil {
+			r.log.Warn().Err(err).Str("request", entry.id).Msg("could not remove execution record")
+		}
+
+		n++
+	}
+
+	r.index = r.index[n:]
+}
+
+func (r *resultStore) expired(completed time.Time) bool {
+	return time.Since(completed) > r.ttl
+}
+
+// recordTime returns the time used to determine record expiry. For executions still in progress this is the start time.
+func recordTime(record bls.ExecutionRecord) time.Time {
+
+	if record.CompletedAt.IsZero() {
+		return record.StartedAt
+	}
+
+	return record.CompletedAt
+}
+
+// saveResult persists the state of an execution request and shares it with other head nodes.
+func (h *HeadNode) saveResult(ctx context.Context, record bls.ExecutionRecord) {
+
+	err := h.results.save(ctx, record)
+	if err != nil {
+		h.Log().Error().Err(err).Str("request", record.RequestID).Msg("could not save execution result")
+	}
+
+	h.shareState(ctx, record)
+}
+
+func (h *HeadNode) runResultPurgeLoop(ctx context.Context) {
+
+	ticker := time.NewTicker(resultPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.results.purge(ctx)
+			h.idempotency.purge(time.Now())
+			h.purgeInstallResults(time.Now())
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/store"
+	"github.com/Maelkum/b7s/store/codec"
+	"github.com/Maelkum/b7s/testing/helpers"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_ResultStore(t *testing.T) {
+
+	var (
+		ctx   = context.Background()
+		ttl   = time.Hour
+		limit = uint(3)
+	)
+
+	t.Run("saved results are retrievable", func(t *testing.T) {
+		t.Parallel()
+
+		db := helpers.InMemoryDB(t)
+		defer db.Close()
+
+		results := newResultStore(mocks.NoopLogger, store.New(db, codec.NewJSONCodec()), ttl, limit)
+
+		record := createExecutionRecord(t, "request-id", time.Now())
+		require.NoError(t, results.save(ctx, record))
+
+		retrieved, ok := results.get(ctx, record.RequestID)
+		require.True(t, ok)
+		require.Equal(t, record.RequestID, retrieved.RequestID)
+		require.Equal(t, record.Results, retrieved.Results)
+
+		_, ok = results.get(ctx, "missing-request-id")
+		require.False(t, ok)
+	})
+	t.Run("oldest results are removed over limit", func(t *testing.T) {
+		t.Parallel()
+
+		db := helpers.InMemoryDB(t)
+		defer db.Close()
+
+		results := newResultStore(mocks.NoopLogger, store.New(db, codec.NewJSONCodec()), ttl, limit)
+
+		now := time.Now()
+		count := int(limit) + 2
+		for i := 0; i < count; i++ {
+			record := createExecutionRecord(t, fmt.Sprintf("request-id-%v", i), now.Add(time.Duration(i)*time.Second))
+			require.NoError(t, results.save(ctx, record))
+		}
+
+		for i := 0; i < count; i++ {
+			_, ok := results.get(ctx, fmt.Sprintf("request-id-%v", i))
+			require.Equal(t, i >= count-int(limit), ok)
+		}
+	})
+	t.Run("expired results are not returned", func(t *testing.T) {
+		t.Parallel()
+
+		db := helpers.InMemoryDB(t)
+		defer db.Close()
+
+		results := newResultStore(mocks.NoopLogger, store.New(db, codec.NewJSONCodec()), ttl, limit)
+
+		record := createExecutionRecord(t, "request-id", time.Now().Add(-2*ttl))
+		require.NoError(t, results.save(ctx, record))
+
+		_, ok := results.get(ctx, record.RequestID)
+		require.False(t, ok)
+	})
+	t.Run("results survive restart", func(t *testing.T) {
+		t.Parallel()
+
+		db := helpers.InMemoryDB(t)
+		defer db.Close()
+
+		var (
+			expired = createExecutionRecord(t, "expired-request-id", time.Now().Add(-2*ttl))
+			valid   = createExecutionRecord(t, "valid-request-id", time.Now())
+		)
+
+		results := newResultStore(mocks.NoopLogger, store.New(db, codec.NewJSONCodec()), 2*ttl+time.Minute, limit)
+		require.NoError(t, results.save(ctx, expired))
+		require.NoError(t, results.save(ctx, valid))
+
+		// Simulate a restart.
+		restarted := newResultStore(mocks.NoopLogger, store.New(db, codec.NewJSONCodec()), ttl, limit)
+		_, err := restarted.load(ctx)
+		require.NoError(t, err)
+
+		_, ok := restarted.get(ctx, valid.RequestID)
+		require.True(t, ok)
+
+		_, ok = restarted.get(ctx, expired.RequestID)
+		require.False(t, ok)
+
+		// Expired record should have been removed from the store too.
+		records, err := store.New(db, codec.NewJSONCodec()).RetrieveExecutions(ctx)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+}
+
+func TestHead_ExecutionStatus(t *testing.T) {
+
+	var (
+		ctx       = context.Background()
+		requestID = "request-id"
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	_, _, ok := head.ExecutionStatus(ctx, requestID)
+	require.False(t, ok)
+
+	// Execution in progress.
+	head.requests.Set(requestID, requestState{status: execute.StatusExecuting})
+
+	status, _, ok := head.ExecutionStatus(ctx, requestID)
+	require.True(t, ok)
+	require.Equal(t, execute.StatusExecuting, status)
+
+	// Execution done.
+	record := createExecutionRecord(t, requestID, time.Now())
+	head.saveResult(ctx, record)
+	head.requests.Delete(requestID)
+
+	status, retrieved, ok := head.ExecutionStatus(ctx, requestID)
+	require.True(t, ok)
+	require.Equal(t, record.Status, status)
+	require.Equal(t, record.Results, retrieved.Results)
+}
+
+func createExecutionRecord(t *testing.T, id string, completed time.Time) bls.ExecutionRecord {
+	t.Helper()
+
+	record := mocks.GenericExecutionRecord
+	record.RequestID = id
+	record.StartedAt = completed.Add(-time.Second)
+	record.CompletedAt = completed
+
+	return record
+}
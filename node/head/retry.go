@@ -0,0 +1,140 @@
+package head
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+)
+
+// replaceFailedPeers finds replacements for peers that did not successfully execute the request, following the request retry policy.
+// Replacements are found using a follow-up roll call, which excludes all peers that were already tried.
+// Returns the updated cluster info and execution results.
+func (h *HeadNode) replaceFailedPeers(
+	ctx context.Context,
+	requestID string,
+	req request.Execute,
+	cluster execute.Cluster,
+	results execute.ResultMap,
+) (execute.Cluster, execute.ResultMap) {
+
+	policy := *req.Config.Retry
+
+	log := h.Log().With().
+		Str("request", requestID).
+		Str("function", req.FunctionID).
+		Logger()
+
+	// Peers that we already tried - we don't want them again.
+	tried := slices.Clone(cluster.Peers)
+
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+
+		failed := failedPeers(cluster.Peers, results)
+		if len(failed) == 0 {
+			break
+		}
+
+		delay := policy.Delay(attempt)
+
+		log.Info().
+			Int("attempt", attempt).
+			Strs("failed", bls.PeerIDsToStr(failed)).
+			Dur("backoff", delay).
+			Msg("looking for replacements for peers that failed to execute the request")
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return cluster, results
+		}
+
+		followup := req
+		followup.Config.NodeCount = len(failed)
+
+		replacements, err := h.executeRollCall(ctx, requestID, followup, 0, tried)
+		if err != nil {
+			log.Warn().Err(err).Int("attempt", attempt).Msg("could not find replacement peers")
+			continue
+		}
+
+		tried = append(tried, replacements.Peers...)
+
+		h.updateRequest(requestID, func(state *requestState) {
+			state.peers = append(state.peers, replacements.Peers...)
+		})
+
+		h.load.add(replacements.Peers)
+		replacementResults, err := h.delegateWorkOrder(ctx, requestID, req, replacements.Peers)
+		h.load.done(replacements.Peers)
+		if err != nil {
+			log.Warn().Err(err).Int("attempt", attempt).Msg("could not delegate work order to replacement peers")
+			continue
+		}
+
+		// Swap failed peers for their replacements.
+		for i, replacement := range replacements.Peers {
+
+			replaced := failed[i]
+
+			idx := slices.Index(cluster.Peers, replaced)
+			cluster.Peers[idx] = replacement
+			cluster.Replacements = append(cluster.Replacements, execute.Replacement{
+				Replaced:    replaced,
+				Replacement: replacement,
+			})
+
+			delete(results, replaced)
+			res, ok := replacementResults[replacement]
+			if ok {
+				results[replacement] = res
+			}
+
+			if cluster.Capacity != nil {
+				delete(cluster.Capacity, replaced)
+			}
+			capacity, ok := replacements.Capacity[replacement]
+			if ok {
+				if cluster.Capacity == nil {
+					cluster.Capacity = make(map[peer.ID]execute.Capacity)
+				}
+				cluster.Capacity[replacement] = capacity
+			}
+
+			log.Info().Stringer("replaced", replaced).Stringer("replacement", replacement).Bool("executed", ok).Msg("replaced peer")
+		}
+	}
+
+	return cluster, results
+}
+
+// delegateWorkOrder sends the work order to the given peers and waits for their execution results.
+func (h *HeadNode) delegateWorkOrder(ctx context.Context, requestID string, req request.Execute, peers []peer.ID) (execute.ResultMap, error) {
+
+	err := h.SendToMany(ctx, peers, req.WorkOrder(requestID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.gatherExecutionResults(ctx, requestID, peers), nil
+}
+
+// failedPeers returns the list of peers that did not successfully execute the request.
+func failedPeers(peers []peer.ID, results execute.ResultMap) []peer.ID {
+
+	var failed []peer.ID
+	for _, p := range peers {
+		res, ok := results[p]
+		if !ok || res.Code != codes.OK {
+			failed = append(failed, p)
+		}
+	}
+
+	return failed
+}
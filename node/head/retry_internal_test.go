@@ -0,0 +1,269 @@
+package head
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_Execute_ReplacesFailedPeers(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+	)
+
+	workers, keys := newWorkers(t, 2)
+	failing, replacement := workers[0], workers[1]
+
+	var (
+		req = mocks.GenericExecutionRequest
+
+		okResult = signedResult(t, keys[replacement], requestID, execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: mocks.GenericExecutionResult.Result,
+			},
+		})
+		errResult = signedResult(t, keys[failing], requestID, execute.NodeResult{
+			Result: execute.Result{Code: codes.Error},
+		})
+
+		lock      sync.Mutex
+		rollCalls int
+	)
+
+	req.Config.NodeCount = 1
+	req.Config.Retry = &execute.RetryPolicy{
+		MaxAttempts: 2,
+		Backoff:     1,
+	}
+
+	head := createHeadNode(t)
+
+	rollCallResponse := func(from peer.ID) rollCallResponse {
+		return rollCallResponse{
+			From: from,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: req.FunctionID,
+				RequestID:  requestID,
+			},
+		}
+	}
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	// First roll call is answered by the failing peer. Follow-up roll call is answered by both peers.
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		rollCalls++
+		head.rollCall.add(requestID, rollCallResponse(failing))
+		if rollCalls > 1 {
+			head.rollCall.add(requestID, rollCallResponse(replacement))
+		}
+
+		return nil
+	}
+	core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+		_, ok := any(msg).(*request.WorkOrder)
+		require.True(t, ok)
+		require.Len(t, peers, 1)
+
+		switch peers[0] {
+		case failing:
+			head.workOrderResponses.Set(peerRequestKey(requestID, failing), errResult)
+		case replacement:
+			head.workOrderResponses.Set(peerRequestKey(requestID, replacement), okResult)
+		}
+
+		return nil
+	}
+	head.Core = core
+
+	code, results, cluster, err := head.execute(context.Background(), requestID, request.Execute{Request: req})
+	require.NoError(t, err)
+
+	require.Equal(t, codes.OK, code)
+	require.Equal(t, 2, rollCalls)
+
+	require.Len(t, results, 1)
+	require.Equal(t, okResult, results[replacement])
+
+	require.Equal(t, []peer.ID{replacement}, cluster.Peers)
+	require.Equal(t, []execute.Replacement{{Replaced: failing, Replacement: replacement}}, cluster.Replacements)
+}
+
+func TestHead_Execute_RetriesExhausted(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+	)
+
+	workers, keys := newWorkers(t, 3)
+
+	var (
+		req = mocks.GenericExecutionRequest
+
+		lock      sync.Mutex
+		rollCalls int
+	)
+
+	req.Config.NodeCount = 1
+	req.Config.Retry = &execute.RetryPolicy{
+		MaxAttempts: 2,
+		Backoff:     1,
+	}
+
+	head := createHeadNode(t)
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	// Each roll call is answered by a new peer.
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		head.rollCall.add(requestID, rollCallResponse{
+			From: workers[rollCalls],
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: req.FunctionID,
+				RequestID:  requestID,
+			},
+		})
+		rollCalls++
+
+		return nil
+	}
+	// All peers fail to execute the request.
+	core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+		for _, p := range peers {
+			head.workOrderResponses.Set(peerRequestKey(requestID, p), signedResult(t, keys[p], requestID, execute.NodeResult{
+				Result: execute.Result{Code: codes.Error},
+			}))
+		}
+		return nil
+	}
+	head.Core = core
+
+	_, _, cluster, err := head.execute(context.Background(), requestID, request.Execute{Request: req})
+	require.ErrorIs(t, err, bls.ErrRetriesExhausted)
+	require.Equal(t, 3, rollCalls)
+	require.Len(t, cluster.Replacements, 2)
+}
+
+func TestHead_Execute_NoRetryWithConsensus(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+	)
+
+	workers, keys := newWorkers(t, 2)
+	failing, replacement := workers[0], workers[1]
+
+	var (
+		req = mocks.GenericExecutionRequest
+
+		errResult = signedResult(t, keys[failing], requestID, execute.NodeResult{
+			Result: execute.Result{Code: codes.Error},
+		})
+
+		lock       sync.Mutex
+		rollCalls  int
+		workOrders int
+	)
+
+	req.Config.NodeCount = 1
+	req.Config.ConsensusAlgorithm = "raft"
+	req.Config.Retry = &execute.RetryPolicy{
+		MaxAttempts: 2,
+		Backoff:     1,
+	}
+
+	head := createHeadNode(t)
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	// Both peers are always available for a roll call.
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		rollCalls++
+		for _, worker := range []peer.ID{failing, replacement} {
+			head.rollCall.add(requestID, rollCallResponse{
+				From: worker,
+				RollCall: response.RollCall{
+					Code:       codes.Accepted,
+					FunctionID: req.FunctionID,
+					RequestID:  requestID,
+				},
+			})
+		}
+
+		return nil
+	}
+	core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+		switch msg.(type) {
+		case *request.FormCluster:
+			for _, p := range peers {
+				head.consensusResponses.Set(consensusResponseKey(requestID, p), response.FormCluster{Code: codes.OK})
+			}
+
+		case *request.WorkOrder:
+			lock.Lock()
+			workOrders++
+			lock.Unlock()
+
+			require.Equal(t, []peer.ID{failing}, peers)
+			head.workOrderResponses.Set(peerRequestKey(requestID, failing), errResult)
+		}
+
+		return nil
+	}
+	head.Core = core
+
+	_, results, cluster, err := head.execute(context.Background(), requestID, request.Execute{Request: req})
+	require.NoError(t, err)
+
+	// No follow-up roll call or work order - the failed peer is not replaced.
+	require.Equal(t, 1, rollCalls)
+	require.Equal(t, 1, workOrders)
+
+	require.Equal(t, errResult, results[failing])
+	require.Equal(t, []peer.ID{failing}, cluster.Peers)
+	require.Empty(t, cluster.Replacements)
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+
+	policy := execute.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     100,
+	}
+
+	require.Zero(t, policy.Delay(0))
+	require.Equal(t, int64(100), policy.Delay(1).Milliseconds())
+	require.Equal(t, int64(200), policy.Delay(2).Milliseconds())
+	require.Equal(t, int64(400), policy.Delay(3).Milliseconds())
+}
@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -13,6 +14,7 @@ import (
 	"github.com/Maelkum/b7s/consensus/pbft"
 	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/models/request"
 	"github.com/Maelkum/b7s/models/response"
 )
@@ -22,7 +24,8 @@ func (h *HeadNode) executeRollCall(
 	requestID string,
 	req request.Execute,
 	consensus cons.Type,
-) ([]peer.ID, error) {
+	exclude []peer.ID,
+) (execute.Cluster, error) {
 
 	// Create a logger with relevant context.
 	log := h.Log().With().
@@ -32,16 +35,36 @@ func (h *HeadNode) executeRollCall(
 		Str("topic", req.Topic).
 		Logger()
 
+	strategy := h.selectionStrategy(req.Config.SelectionStrategy)
+
+	// Peers the request explicitly asked to avoid are skipped, same as the ones the caller asked us to skip.
+	exclude = append(slices.Clone(exclude), req.Config.ExcludePeers...)
+	include := req.Config.IncludePeers
+
 	log.Info().Msg("performing roll call for request")
 
 	h.rollCall.create(requestID)
 	defer h.rollCall.remove(requestID)
 
-	err := h.publishRollCall(ctx, req.RollCall(requestID, consensus), req.Topic)
-	if err != nil {
-		return nil, fmt.Errorf("could not publish roll call: %w", err)
+	// If the request targets specific peers, we send the roll call to them directly instead of publishing it.
+	// Then we know which peers can respond.
+	var targets []peer.ID
+	rc := req.RollCall(requestID, consensus)
+	if len(include) > 0 {
+		var err error
+		targets, err = h.sendRollCall(ctx, rc, include, exclude)
+		if err != nil {
+			return execute.Cluster{}, fmt.Errorf("could not send roll call: %w", err)
+		}
+	} else {
+		err := h.publishRollCall(ctx, rc, req.Topic)
+		if err != nil {
+			return execute.Cluster{}, fmt.Errorf("could not publish roll call: %w", err)
+		}
 	}
 
+	published := time.Now()
+
 	log.Info().Msg("roll call published")
 
 	// Limit for how long we wait for responses.
@@ -52,25 +75,83 @@ func (h *HeadNode) executeRollCall(
 	tctx, cancel := context.WithTimeout(ctx, t)
 	defer cancel()
 
+	// Unless any number of peers will do (-1), we need at least one peer.
 	nodeCount := req.Config.NodeCount
+	if nodeCount != -1 {
+		nodeCount = max(nodeCount, 1)
+	}
+
+	// Some strategies choose among all peers that responded within the selection window.
+	// Others choose peers as soon as enough of them report.
+	var window <-chan time.Time
+	windowClosed := !strategy.Collect()
+	if !windowClosed {
+		timer := time.NewTimer(h.cfg.SelectionWindow)
+		defer timer.Stop()
+		window = timer.C
+	}
+
+	// -1 means we'll take any peers reporting, so we wait for the roll call to time out.
+	enough := func(n int) bool {
+		return nodeCount != -1 && n >= nodeCount
+	}
 
-	// Peers that have reported on roll call.
-	var reportingPeers []peer.ID
+	// Peers that have reported on roll call. Peers with low reputation are only used if there are not enough other peers.
+	// For direct roll calls, we also track which of the targeted peers responded, whether they accepted or not.
+	var (
+		candidates    []Candidate
+		deprioritized []Candidate
+		responded     []peer.ID
+	)
 rollCallResponseLoop:
 	for {
+		// -1 means we'll take any peers reporting - if we know all peers that could report did, there's no point in waiting for more.
+		if nodeCount == -1 && len(targets) > 0 && len(responded) == len(targets) {
+			reported := h.withDeprioritized(candidates, deprioritized, nodeCount)
+			if len(reported) > 0 {
+				log.Info().Msg("all targeted peers responded to roll call")
+				candidates = reported
+				break rollCallResponseLoop
+			}
+		}
+
 		// Wait for responses from nodes who want to work on the request.
 		select {
 		// Request timed out.
 		case <-tctx.Done():
 
+			if len(deprioritized) > 0 {
+				candidates = h.withDeprioritized(candidates, deprioritized, nodeCount)
+				if enough(len(candidates)) {
+					log.Info().Int("deprioritized", len(deprioritized)).Msg("enough peers reported for roll call, including peers with low reputation")
+					break rollCallResponseLoop
+				}
+			}
+
+			// Timeout may expire before the selection window closes - choose among the peers we have, if there's enough of them.
+			if enough(len(candidates)) {
+				log.Info().Msg("enough peers reported for roll call")
+				break rollCallResponseLoop
+			}
+
 			// -1 means we'll take any peers reporting
-			if len(reportingPeers) >= 1 && nodeCount == -1 {
+			if len(candidates) >= 1 && nodeCount == -1 {
 				log.Info().Msg("enough peers reported for roll call")
 				break rollCallResponseLoop
 			}
 
 			log.Warn().Msg("roll call timed out")
-			return nil, bls.ErrRollCallTimeout
+			return execute.Cluster{}, bls.ErrRollCallTimeout
+
+		case <-window:
+
+			windowClosed = true
+			window = nil
+
+			if enough(len(candidates)) {
+				log.Info().Msg("enough peers reported for roll call")
+				break rollCallResponseLoop
+			}
 
 		case reply := <-h.rollCall.responses(requestID):
 
@@ -83,6 +164,28 @@ rollCallResponseLoop:
 				continue
 			}
 
+			// Check if this peer should be skipped.
+			if slices.Contains(exclude, reply.From) {
+				log.Debug().Stringer("peer", reply.From).Msg("skipping roll call response from excluded peer")
+				continue
+			}
+
+			// Check if this peer was one of the peers targeted by the request.
+			if len(include) > 0 && !slices.Contains(include, reply.From) {
+				log.Debug().Stringer("peer", reply.From).Msg("skipping roll call response from peer not targeted by the request")
+				continue
+			}
+
+			if slices.Contains(targets, reply.From) && !slices.Contains(responded, reply.From) {
+				responded = append(responded, reply.From)
+			}
+
+			// Check if the peer wants to work on the request.
+			if reply.Code != codes.Accepted {
+				log.Info().Stringer("peer", reply.From).Stringer("code", reply.Code).Msg("skipping inadequate roll call response - unwanted code")
+				continue
+			}
+
 			// Check if we are connected to this peer.
 			// Since we receive responses to roll call via direct messages - should not happen.
 			if !h.Connected(reply.From) {
@@ -92,23 +195,74 @@ rollCallResponseLoop:
 				continue
 			}
 
-			log.Info().Stringer("peer", reply.From).Msg("roll called peer chosen for execution")
+			log.Debug().Stringer("peer", reply.From).Any("capacity", reply.Capacity).Msg("peer reported for roll call")
 
-			reportingPeers = append(reportingPeers, reply.From)
+			candidate := Candidate{
+				ID:           reply.From,
+				ResponseTime: time.Since(published),
+				Capacity:     reply.Capacity,
+			}
 
-			// -1 means we'll take any peers reporting
-			if len(reportingPeers) >= nodeCount && nodeCount != -1 {
+			// Check if this peer has a low reputation.
+			if h.lowReputation(reply.From) {
+
+				if h.cfg.ReputationPolicy == ReputationIgnore {
+					log.Debug().Stringer("peer", reply.From).Msg("skipping roll call response from peer with low reputation")
+					continue
+				}
+
+				log.Debug().Stringer("peer", reply.From).Msg("deprioritizing roll call response from peer with low reputation")
+				deprioritized = append(deprioritized, candidate)
+				continue
+			}
+
+			candidates = append(candidates, candidate)
+
+			if windowClosed && enough(len(candidates)) {
 				log.Info().Msg("enough peers reported for roll call")
 				break rollCallResponseLoop
 			}
 		}
 	}
 
+	count := nodeCount
+	if count == -1 {
+		count = len(candidates)
+	}
+
+	reportingPeers := strategy.Select(candidates, count)
+
+	// Record capacity of chosen peers, if they reported it.
+	capacity := make(map[peer.ID]execute.Capacity)
+	for _, c := range candidates {
+		if c.Capacity != nil && slices.Contains(reportingPeers, c.ID) {
+			capacity[c.ID] = *c.Capacity
+		}
+	}
+
+	for _, rp := range reportingPeers {
+
+		log.Info().Stringer("peer", rp).Any("capacity", capacity[rp]).Msg("roll called peer chosen for execution")
+
+		h.reportProgress(execute.Event{
+			Type:      execute.EventRollCall,
+			RequestID: requestID,
+			Peer:      rp,
+		})
+	}
+
 	if consensus == cons.PBFT && len(reportingPeers) < pbft.MinimumReplicaCount {
-		return nil, fmt.Errorf("not enough peers reported for PBFT consensus (have: %v, need: %v)", len(reportingPeers), pbft.MinimumReplicaCount)
+		return execute.Cluster{}, fmt.Errorf("not enough peers reported for PBFT consensus (have: %v, need: %v)", len(reportingPeers), pbft.MinimumReplicaCount)
 	}
 
-	return reportingPeers, nil
+	cluster := execute.Cluster{
+		Peers: reportingPeers,
+	}
+	if len(capacity) > 0 {
+		cluster.Capacity = capacity
+	}
+
+	return cluster, nil
 }
 
 // publishRollCall will create a roll call request for executing the given function.
@@ -129,6 +283,41 @@ func (h *HeadNode) publishRollCall(ctx context.Context, rc *request.RollCall, su
 	return nil
 }
 
+// sendRollCall will send the roll call request directly to the given peers. Excluded peers and peers we are not connected to are skipped.
+// Returns the list of peers the roll call was sent to.
+func (h *HeadNode) sendRollCall(ctx context.Context, rc *request.RollCall, peers []peer.ID, exclude []peer.ID) ([]peer.ID, error) {
+
+	targets := make([]peer.ID, 0, len(peers))
+	for _, id := range peers {
+
+		if slices.Contains(exclude, id) {
+			continue
+		}
+
+		if !h.Connected(id) {
+			h.Log().Warn().Str("request", rc.RequestID).Stringer("peer", id).Msg("skipping roll call for peer we are not connected to")
+			continue
+		}
+
+		targets = append(targets, id)
+	}
+
+	if len(targets) == 0 {
+		return nil, bls.ErrPeersNotConnected
+	}
+
+	err := h.SendToMany(ctx, targets, rc, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not send to peers: %w", err)
+	}
+
+	h.Metrics().IncrCounterWithLabels(rollCallsPublishedMetric, 1, []metrics.Label{
+		{Name: "function", Value: rc.FunctionID},
+	})
+
+	return targets, nil
+}
+
 func (h *HeadNode) processRollCallResponse(ctx context.Context, from peer.ID, res response.RollCall) error {
 
 	log := h.Log().With().
@@ -138,11 +327,7 @@ func (h *HeadNode) processRollCallResponse(ctx context.Context, from peer.ID, re
 
 	log.Debug().Msg("processing peer's roll call response")
 
-	// Check if the response is adequate.
-	if res.Code != codes.Accepted {
-		log.Info().Stringer("code", res.Code).Msg("skipping inadequate roll call response - unwanted code")
-		return nil
-	}
+	h.markWorker(from)
 
 	// Check if there's an active roll call already.
 	exists := h.rollCall.exists(res.RequestID)
@@ -151,7 +336,8 @@ func (h *HeadNode) processRollCallResponse(ctx context.Context, from peer.ID, re
 		return nil
 	}
 
-	log.Info().Msg("recording roll call response")
+	// Responses of peers that do not want to work on the request are recorded too, so that we know they responded.
+	log.Info().Stringer("code", res.Code).Msg("recording roll call response")
 
 	rres := rollCallResponse{
 		From:     from,
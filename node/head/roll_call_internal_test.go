@@ -0,0 +1,223 @@
+package head
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_RollCall_TargetedPeers(t *testing.T) {
+
+	var (
+		workers      = mocks.GenericPeerIDs[:3]
+		disconnected = mocks.GenericPeerIDs[3]
+	)
+
+	// Setup a head node where all workers that receive the roll call respond to it.
+	// When published, the roll call reaches all workers. When sent directly, it reaches only the targeted peers.
+	setupHead := func(t *testing.T, published *atomic.Int32, sent chan<- []peer.ID) *HeadNode {
+		t.Helper()
+
+		head := createHeadNode(t)
+
+		respond := func(rc *request.RollCall, peers []peer.ID) {
+			for _, peer := range peers {
+				head.rollCall.add(rc.RequestID, rollCallResponse{
+					From: peer,
+					RollCall: response.RollCall{
+						Code:       codes.Accepted,
+						FunctionID: rc.FunctionID,
+						RequestID:  rc.RequestID,
+					},
+				})
+			}
+		}
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(id peer.ID) bool {
+			return id != disconnected
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			published.Add(1)
+			respond(rc, workers)
+
+			return nil
+		}
+		core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			sent <- peers
+			respond(rc, peers)
+
+			return nil
+		}
+		head.Core = core
+
+		return head
+	}
+
+	createRequest := func(nodeCount int, include []peer.ID, exclude []peer.ID) request.Execute {
+
+		req := mocks.GenericExecutionRequest
+		req.Config.NodeCount = nodeCount
+		req.Config.Timeout = 1
+		req.Config.IncludePeers = include
+		req.Config.ExcludePeers = exclude
+
+		return request.Execute{Request: req}
+	}
+
+	t.Run("roll call is sent directly to included peers", func(t *testing.T) {
+		t.Parallel()
+
+		var published atomic.Int32
+		sent := make(chan []peer.ID, 1)
+		head := setupHead(t, &published, sent)
+
+		include := []peer.ID{workers[1], workers[2]}
+
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(2, include, nil), 0, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, int32(0), published.Load())
+		require.Equal(t, include, <-sent)
+		require.ElementsMatch(t, include, cluster.Peers)
+	})
+	t.Run("disconnected included peers are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		var published atomic.Int32
+		sent := make(chan []peer.ID, 1)
+		head := setupHead(t, &published, sent)
+
+		include := []peer.ID{workers[0], disconnected}
+
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(1, include, nil), 0, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []peer.ID{workers[0]}, <-sent)
+		require.Equal(t, []peer.ID{workers[0]}, cluster.Peers)
+	})
+	t.Run("roll call fails if no included peer is connected", func(t *testing.T) {
+		t.Parallel()
+
+		var published atomic.Int32
+		sent := make(chan []peer.ID, 1)
+		head := setupHead(t, &published, sent)
+
+		_, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(1, []peer.ID{disconnected}, nil), 0, nil)
+		require.ErrorIs(t, err, bls.ErrPeersNotConnected)
+		require.Equal(t, int32(0), published.Load())
+	})
+	t.Run("excluded peers are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		var published atomic.Int32
+		sent := make(chan []peer.ID, 1)
+		head := setupHead(t, &published, sent)
+
+		exclude := []peer.ID{workers[0]}
+
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(-1, nil, exclude), 0, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, int32(1), published.Load())
+		require.ElementsMatch(t, workers[1:], cluster.Peers)
+	})
+	t.Run("request exclusions are combined with existing ones", func(t *testing.T) {
+		t.Parallel()
+
+		var published atomic.Int32
+		sent := make(chan []peer.ID, 1)
+		head := setupHead(t, &published, sent)
+
+		exclude := []peer.ID{workers[0]}
+		tried := []peer.ID{workers[1]}
+
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), createRequest(-1, nil, exclude), 0, tried)
+		require.NoError(t, err)
+
+		require.Equal(t, []peer.ID{workers[2]}, cluster.Peers)
+		// Peers excluded by the caller are not modified.
+		require.Equal(t, []peer.ID{workers[1]}, tried)
+	})
+	t.Run("peers are chosen when roll call times out before selection window closes", func(t *testing.T) {
+		t.Parallel()
+
+		var published atomic.Int32
+		sent := make(chan []peer.ID, 1)
+		head := setupHead(t, &published, sent)
+		head.cfg.SelectionWindow = 5 * time.Second
+
+		req := createRequest(2, nil, nil)
+		req.Config.SelectionStrategy = execute.SelectionRandom
+
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), req, 0, nil)
+		require.NoError(t, err)
+
+		require.Len(t, cluster.Peers, 2)
+		require.Subset(t, workers, cluster.Peers)
+	})
+	t.Run("direct roll call for any number of peers ends once all targeted peers respond", func(t *testing.T) {
+		t.Parallel()
+
+		var published atomic.Int32
+		sent := make(chan []peer.ID, 1)
+		head := setupHead(t, &published, sent)
+
+		// First peer declines the roll call, second one accepts it.
+		include := []peer.ID{workers[0], workers[1]}
+		replies := map[peer.ID]codes.Code{
+			workers[0]: codes.Error,
+			workers[1]: codes.Accepted,
+		}
+
+		core := head.Core.(*mocks.NodeCore)
+		core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+			require.Equal(t, include, peers)
+
+			for _, peer := range peers {
+				head.rollCall.add(rc.RequestID, rollCallResponse{
+					From: peer,
+					RollCall: response.RollCall{
+						Code:       replies[peer],
+						FunctionID: rc.FunctionID,
+						RequestID:  rc.RequestID,
+					},
+				})
+			}
+
+			return nil
+		}
+
+		req := createRequest(-1, include, nil)
+		req.Config.Timeout = 30
+
+		start := time.Now()
+		cluster, err := head.executeRollCall(context.Background(), newRequestID(), req, 0, nil)
+		require.NoError(t, err)
+
+		require.Less(t, time.Since(start), 5*time.Second)
+		require.Equal(t, []peer.ID{workers[1]}, cluster.Peers)
+	})
+}
@@ -0,0 +1,113 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+)
+
+// CreateSchedule registers a recurring execution of the request, run according to the cron expression.
+func (h *HeadNode) CreateSchedule(ctx context.Context, cron string, req execute.Request, subgroup string) (bls.Schedule, error) {
+
+	schedule := bls.Schedule{
+		ID:        newRequestID(),
+		Cron:      cron,
+		Request:   req,
+		Topic:     subgroup,
+		CreatedAt: time.Now(),
+	}
+
+	err := schedule.Valid()
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	err = h.schedules.add(ctx, schedule)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not add schedule: %w", err)
+	}
+
+	h.Log().Info().Str("schedule", schedule.ID).Str("cron", cron).Str("function", req.FunctionID).Msg("execution schedule created")
+
+	return schedule, nil
+}
+
+// Schedules returns all registered schedules.
+func (h *HeadNode) Schedules(_ context.Context) []bls.Schedule {
+	return h.schedules.list()
+}
+
+// PauseSchedule pauses or resumes the schedule.
+func (h *HeadNode) PauseSchedule(ctx context.Context, id string, paused bool) (bls.Schedule, error) {
+	return h.schedules.pause(ctx, id, paused)
+}
+
+// DeleteSchedule removes the schedule. Execution already in progress is not affected.
+func (h *HeadNode) DeleteSchedule(ctx context.Context, id string) error {
+	return h.schedules.remove(ctx, id)
+}
+
+func (h *HeadNode) runScheduleLoop(ctx context.Context) {
+
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			for _, schedule := range h.schedules.due(now) {
+				go h.runSchedule(ctx, schedule)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runSchedule executes the scheduled request and records the outcome in the schedule history.
+func (h *HeadNode) runSchedule(ctx context.Context, schedule bls.Schedule) {
+
+	requestID := newRequestID()
+
+	log := h.Log().With().Str("schedule", schedule.ID).Str("request", requestID).Logger()
+	log.Debug().Msg("running scheduled execution")
+
+	run := bls.ScheduleRun{
+		RequestID: requestID,
+		StartedAt: time.Now(),
+	}
+
+	ticket, err := h.admission.reserve()
+	if err != nil {
+		run.Code = codes.TooManyRequests
+		run.Message = err.Error()
+	} else {
+		run.Code, _, _, err = h.executeAndSave(ctx, requestID, request.Execute{Request: schedule.Request, Topic: schedule.Topic}, ticket)
+		run.Message = bls.FailureMessage(err)
+	}
+
+	run.CompletedAt = time.Now()
+
+	if err != nil {
+		log.Warn().Err(err).Msg("scheduled execution failed")
+	}
+
+	h.Metrics().IncrCounterWithLabels(scheduledExecutionsMetric, 1,
+		[]metrics.Label{
+			{Name: "function", Value: schedule.Request.FunctionID},
+			{Name: "code", Value: run.Code.String()},
+		})
+
+	err = h.schedules.record(ctx, schedule.ID, run)
+	if err != nil {
+		log.Error().Err(err).Msg("could not record scheduled execution")
+	}
+}
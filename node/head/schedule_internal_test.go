@@ -0,0 +1,190 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/store"
+	"github.com/Maelkum/b7s/store/codec"
+	"github.com/Maelkum/b7s/testing/helpers"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestScheduler(t *testing.T) {
+
+	createSchedule := func(id string) bls.Schedule {
+		return bls.Schedule{
+			ID:        id,
+			Cron:      "@every 1m",
+			Request:   mocks.GenericExecutionRequest,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	t.Run("schedules are persisted", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx = context.Background()
+			db  = helpers.InMemoryDB(t)
+		)
+
+		schedules := newScheduler(store.New(db, codec.NewJSONCodec()), scheduleHistorySize)
+		require.NoError(t, schedules.add(ctx, createSchedule("first")))
+		require.NoError(t, schedules.add(ctx, createSchedule("second")))
+
+		_, err := schedules.pause(ctx, "second", true)
+		require.NoError(t, err)
+
+		require.NoError(t, schedules.add(ctx, createSchedule("third")))
+		require.NoError(t, schedules.remove(ctx, "third"))
+
+		restarted := newScheduler(store.New(db, codec.NewJSONCodec()), scheduleHistorySize)
+		require.NoError(t, restarted.load(ctx))
+
+		list := restarted.list()
+		require.Len(t, list, 2)
+		require.Equal(t, "first", list[0].ID)
+		require.False(t, list[0].Paused)
+		require.Equal(t, "second", list[1].ID)
+		require.True(t, list[1].Paused)
+	})
+	t.Run("paused and running schedules are not due", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		schedules := newScheduler(mocks.BaselineStore(t), scheduleHistorySize)
+		require.NoError(t, schedules.add(ctx, createSchedule("first")))
+		require.NoError(t, schedules.add(ctx, createSchedule("second")))
+
+		_, err := schedules.pause(ctx, "second", true)
+		require.NoError(t, err)
+
+		require.Empty(t, schedules.due(time.Now()))
+
+		later := time.Now().Add(time.Minute)
+
+		due := schedules.due(later)
+		require.Len(t, due, 1)
+		require.Equal(t, "first", due[0].ID)
+
+		// Previous run is still in progress.
+		require.Empty(t, schedules.due(later.Add(time.Minute)))
+
+		require.NoError(t, schedules.record(ctx, "first", bls.ScheduleRun{Code: codes.OK}))
+		require.Len(t, schedules.due(later.Add(time.Minute)), 1)
+	})
+	t.Run("history is bounded", func(t *testing.T) {
+		t.Parallel()
+
+		const historySize = 3
+
+		ctx := context.Background()
+
+		schedules := newScheduler(mocks.BaselineStore(t), historySize)
+		require.NoError(t, schedules.add(ctx, createSchedule("first")))
+
+		for i := range 2 * historySize {
+			run := bls.ScheduleRun{RequestID: newRequestID(), StartedAt: time.Unix(int64(i), 0)}
+			require.NoError(t, schedules.record(ctx, "first", run))
+		}
+
+		history := schedules.list()[0].History
+		require.Len(t, history, historySize)
+		require.Equal(t, time.Unix(2*historySize-1, 0), history[historySize-1].StartedAt)
+	})
+	t.Run("missing schedule", func(t *testing.T) {
+		t.Parallel()
+
+		schedules := newScheduler(mocks.BaselineStore(t), scheduleHistorySize)
+
+		_, err := schedules.pause(context.Background(), "missing", true)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+		require.ErrorIs(t, schedules.remove(context.Background(), "missing"), bls.ErrNotFound)
+	})
+}
+
+func TestHead_RunSchedule(t *testing.T) {
+
+	workers, keys := newWorkers(t, 1)
+	worker := workers[0]
+
+	head := createHeadNode(t)
+	head.results = newResultStore(mocks.NoopLogger, store.New(helpers.InMemoryDB(t), codec.NewJSONCodec()), time.Hour, 100)
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+
+		rc, ok := any(msg).(*request.RollCall)
+		require.True(t, ok)
+
+		head.rollCall.add(rc.RequestID, rollCallResponse{
+			From: worker,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: rc.FunctionID,
+				RequestID:  rc.RequestID,
+			},
+		})
+
+		return nil
+	}
+	core.SendToManyFunc = func(_ context.Context, _ []peer.ID, msg bls.Message, _ bool) error {
+
+		wo, ok := any(msg).(*request.WorkOrder)
+		require.True(t, ok)
+
+		head.workOrderResponses.Set(peerRequestKey(wo.RequestID, worker), signedResult(t, keys[worker], wo.RequestID, execute.NodeResult{
+			Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "scheduled-output"}},
+		}))
+
+		return nil
+	}
+	head.Core = core
+
+	ctx := context.Background()
+
+	req := mocks.GenericExecutionRequest
+	req.Config.NodeCount = 1
+
+	schedule, err := head.CreateSchedule(ctx, "@every 1m", req, "")
+	require.NoError(t, err)
+
+	_, err = head.CreateSchedule(ctx, "not a cron expression", req, "")
+	require.Error(t, err)
+
+	due := head.schedules.due(time.Now().Add(time.Minute))
+	require.Len(t, due, 1)
+
+	head.runSchedule(ctx, due[0])
+
+	schedules := head.Schedules(ctx)
+	require.Len(t, schedules, 1)
+	require.Equal(t, schedule.ID, schedules[0].ID)
+
+	history := schedules[0].History
+	require.Len(t, history, 1)
+	require.Equal(t, codes.OK, history[0].Code)
+
+	// Result of the scheduled execution can be retrieved using the request ID.
+	record, ok := head.ExecutionResult(ctx, history[0].RequestID)
+	require.True(t, ok)
+	require.Equal(t, codes.OK, record.Code)
+	require.Equal(t, "scheduled-output", record.Results[worker].Result.Result.Stdout)
+
+	require.NoError(t, head.DeleteSchedule(ctx, schedule.ID))
+	require.Empty(t, head.Schedules(ctx))
+}
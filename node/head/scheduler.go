@@ -0,0 +1,218 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/Maelkum/b7s/models/bls"
+)
+
+// scheduler keeps track of recurring executions. Schedules are persisted so they survive node restarts.
+type scheduler struct {
+	store       bls.ScheduleStore
+	historySize int
+
+	lock    sync.Mutex
+	entries map[string]*scheduleEntry
+}
+
+type scheduleEntry struct {
+	schedule bls.Schedule
+	cron     cron.Schedule
+	next     time.Time // Time of the next run.
+	running  bool      // Schedule has an execution in progress.
+}
+
+func newScheduler(store bls.ScheduleStore, historySize int) *scheduler {
+
+	s := scheduler{
+		store:       store,
+		historySize: historySize,
+		entries:     make(map[string]*scheduleEntry),
+	}
+
+	return &s
+}
+
+// load reads the persisted schedules.
+func (s *scheduler) load(ctx context.Context) error {
+
+	schedules, err := s.store.RetrieveSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve schedules: %w", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	for _, schedule := range schedules {
+
+		cron, err := bls.ParseCron(schedule.Cron)
+		if err != nil {
+			return fmt.Errorf("could not parse schedule (id: %s): %w", schedule.ID, err)
+		}
+
+		s.entries[schedule.ID] = &scheduleEntry{
+			schedule: schedule,
+			cron:     cron,
+			next:     cron.Next(now),
+		}
+	}
+
+	return nil
+}
+
+func (s *scheduler) add(ctx context.Context, schedule bls.Schedule) error {
+
+	cron, err := bls.ParseCron(schedule.Cron)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err = s.store.SaveSchedule(ctx, schedule)
+	if err != nil {
+		return fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	s.entries[schedule.ID] = &scheduleEntry{
+		schedule: schedule,
+		cron:     cron,
+		next:     cron.Next(time.Now()),
+	}
+
+	return nil
+}
+
+// list returns all schedules, oldest first.
+func (s *scheduler) list() []bls.Schedule {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	schedules := make([]bls.Schedule, 0, len(s.entries))
+	for _, entry := range s.entries {
+		schedules = append(schedules, cloneSchedule(entry.schedule))
+	}
+
+	slices.SortFunc(schedules, func(a, b bls.Schedule) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return schedules
+}
+
+// pause pauses or resumes the schedule.
+func (s *scheduler) pause(ctx context.Context, id string, paused bool) (bls.Schedule, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	entry, ok := s.entries[id]
+	if !ok {
+		return bls.Schedule{}, bls.ErrNotFound
+	}
+
+	updated := entry.schedule
+	updated.Paused = paused
+
+	err := s.store.SaveSchedule(ctx, updated)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	entry.schedule = updated
+
+	// Don't run the missed executions when the schedule is resumed.
+	if !paused {
+		entry.next = entry.cron.Next(time.Now())
+	}
+
+	return cloneSchedule(updated), nil
+}
+
+func (s *scheduler) remove(ctx context.Context, id string) error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.entries[id]
+	if !ok {
+		return bls.ErrNotFound
+	}
+
+	err := s.store.RemoveSchedule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not remove schedule: %w", err)
+	}
+
+	delete(s.entries, id)
+
+	return nil
+}
+
+// due returns the schedules that should run now and marks them as running.
+// Schedules with an execution still in progress are skipped.
+func (s *scheduler) due(now time.Time) []bls.Schedule {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var due []bls.Schedule
+	for _, entry := range s.entries {
+
+		if entry.schedule.Paused || entry.running || now.Before(entry.next) {
+			continue
+		}
+
+		entry.running = true
+		entry.next = entry.cron.Next(now)
+
+		due = append(due, cloneSchedule(entry.schedule))
+	}
+
+	return due
+}
+
+// record adds the run to the schedule history, keeping only the most recent runs.
+func (s *scheduler) record(ctx context.Context, id string, run bls.ScheduleRun) error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Schedule was removed in the meantime.
+	entry, ok := s.entries[id]
+	if !ok {
+		return nil
+	}
+
+	entry.running = false
+
+	updated := cloneSchedule(entry.schedule)
+	updated.History = append(updated.History, run)
+	if len(updated.History) > s.historySize {
+		updated.History = updated.History[len(updated.History)-s.historySize:]
+	}
+
+	entry.schedule = updated
+
+	err := s.store.SaveSchedule(ctx, updated)
+	if err != nil {
+		return fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	return nil
+}
+
+func cloneSchedule(schedule bls.Schedule) bls.Schedule {
+	schedule.History = slices.Clone(schedule.History)
+	return schedule
+}
@@ -0,0 +1,195 @@
+package head
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// Candidate is a peer that reported for roll call.
+type Candidate struct {
+	ID           peer.ID
+	ResponseTime time.Duration     // How long after the roll call was published did the response arrive.
+	Capacity     *execute.Capacity // Capacity the peer reported, if any.
+}
+
+// SelectionStrategy determines which of the peers that reported for roll call will execute the request.
+type SelectionStrategy interface {
+	// Collect reports whether roll call responses should be collected for the duration of the selection window before
+	// peers are chosen. If not, peers are chosen as soon as enough of them respond.
+	Collect() bool
+	// Select chooses up to `count` peers among the candidates. Candidates are ordered by the arrival of their roll call response.
+	Select(candidates []Candidate, count int) []peer.ID
+}
+
+// builtinStrategies returns the selection strategies supported out of the box.
+func (h *HeadNode) builtinStrategies() map[string]SelectionStrategy {
+
+	strategies := map[string]SelectionStrategy{
+		execute.SelectionFirstCome:     firstComeStrategy{},
+		execute.SelectionRandom:        randomStrategy{},
+		execute.SelectionLeastLoaded:   leastLoadedStrategy{load: h.load},
+		execute.SelectionLowestLatency: lowestLatencyStrategy{latency: h.Host().Peerstore().LatencyEWMA},
+	}
+
+	return strategies
+}
+
+// selectionStrategy returns the selection strategy with the given name. If the strategy is not specified or unknown, the default strategy is used.
+func (h *HeadNode) selectionStrategy(name string) SelectionStrategy {
+
+	if name == "" {
+		name = h.cfg.DefaultSelection
+	}
+
+	strategy, ok := h.strategies[name]
+	if !ok {
+		h.Log().Warn().
+			Str("value", name).
+			Str("default", h.cfg.DefaultSelection).
+			Msg("unknown selection strategy requested, using default")
+
+		return h.strategies[h.cfg.DefaultSelection]
+	}
+
+	return strategy
+}
+
+// firstComeStrategy chooses peers in the order in which they responded.
+type firstComeStrategy struct{}
+
+func (firstComeStrategy) Collect() bool { return false }
+
+func (firstComeStrategy) Select(candidates []Candidate, count int) []peer.ID {
+	return candidateIDs(candidates, count)
+}
+
+// randomStrategy chooses peers randomly.
+type randomStrategy struct{}
+
+func (randomStrategy) Collect() bool { return true }
+
+func (randomStrategy) Select(candidates []Candidate, count int) []peer.ID {
+
+	shuffled := slices.Clone(candidates)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return candidateIDs(shuffled, count)
+}
+
+// leastLoadedStrategy chooses peers with the fewest executions in progress. If the peer reported its capacity, that is used.
+// Otherwise, we rely on the number of executions this head node has delegated to the peer.
+type leastLoadedStrategy struct {
+	load *peerLoad
+}
+
+func (leastLoadedStrategy) Collect() bool { return true }
+
+func (s leastLoadedStrategy) Select(candidates []Candidate, count int) []peer.ID {
+
+	load := func(c Candidate) uint {
+		if c.Capacity != nil {
+			return c.Capacity.Executions + c.Capacity.Queued
+		}
+		return s.load.get(c.ID)
+	}
+
+	sorted := slices.Clone(candidates)
+	slices.SortStableFunc(sorted, func(a, b Candidate) int {
+		return cmp.Compare(load(a), load(b))
+	})
+
+	return candidateIDs(sorted, count)
+}
+
+// lowestLatencyStrategy chooses peers with the lowest latency. If the latency to a peer is not known,
+// the roll call response time is used instead.
+type lowestLatencyStrategy struct {
+	latency func(peer.ID) time.Duration
+}
+
+func (lowestLatencyStrategy) Collect() bool { return true }
+
+func (s lowestLatencyStrategy) Select(candidates []Candidate, count int) []peer.ID {
+
+	latency := func(c Candidate) time.Duration {
+		l := s.latency(c.ID)
+		if l == 0 {
+			return c.ResponseTime
+		}
+		return l
+	}
+
+	sorted := slices.Clone(candidates)
+	slices.SortStableFunc(sorted, func(a, b Candidate) int {
+		return cmp.Compare(latency(a), latency(b))
+	})
+
+	return candidateIDs(sorted, count)
+}
+
+func candidateIDs(candidates []Candidate, count int) []peer.ID {
+
+	count = min(count, len(candidates))
+
+	ids := make([]peer.ID, 0, count)
+	for _, c := range candidates[:count] {
+		ids = append(ids, c.ID)
+	}
+
+	return ids
+}
+
+// peerLoad keeps track of the number of executions each peer is working on, as far as this head node knows.
+type peerLoad struct {
+	sync.Mutex
+	m map[peer.ID]uint
+}
+
+func newPeerLoad() *peerLoad {
+
+	l := peerLoad{
+		m: make(map[peer.ID]uint),
+	}
+
+	return &l
+}
+
+// add records that the peers started working on an execution.
+func (l *peerLoad) add(peers []peer.ID) {
+	l.Lock()
+	defer l.Unlock()
+
+	for _, p := range peers {
+		l.m[p]++
+	}
+}
+
+// done records that the peers are done working on an execution.
+func (l *peerLoad) done(peers []peer.ID) {
+	l.Lock()
+	defer l.Unlock()
+
+	for _, p := range peers {
+		if l.m[p] <= 1 {
+			delete(l.m, p)
+			continue
+		}
+		l.m[p]--
+	}
+}
+
+func (l *peerLoad) get(p peer.ID) uint {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.m[p]
+}
@@ -0,0 +1,106 @@
+package head
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_SelectionStrategies(t *testing.T) {
+
+	var (
+		first  = mocks.GenericPeerIDs[0]
+		second = mocks.GenericPeerIDs[1]
+		third  = mocks.GenericPeerIDs[2]
+
+		// Candidates in order of arrival.
+		candidates = []Candidate{
+			{ID: first, ResponseTime: 10 * time.Millisecond},
+			{ID: second, ResponseTime: 20 * time.Millisecond},
+			{ID: third, ResponseTime: 30 * time.Millisecond},
+		}
+	)
+
+	t.Run("first come", func(t *testing.T) {
+		t.Parallel()
+
+		var strategy firstComeStrategy
+		require.False(t, strategy.Collect())
+		require.Equal(t, []peer.ID{first, second}, strategy.Select(candidates, 2))
+		require.Equal(t, []peer.ID{first, second, third}, strategy.Select(candidates, 5))
+	})
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+
+		var strategy randomStrategy
+		require.True(t, strategy.Collect())
+
+		selected := strategy.Select(candidates, 2)
+		require.Len(t, selected, 2)
+		require.NotEqual(t, selected[0], selected[1])
+		for _, id := range selected {
+			require.Contains(t, []peer.ID{first, second, third}, id)
+		}
+
+		require.ElementsMatch(t, []peer.ID{first, second, third}, strategy.Select(candidates, 3))
+	})
+	t.Run("least loaded", func(t *testing.T) {
+		t.Parallel()
+
+		load := newPeerLoad()
+		load.add([]peer.ID{first, second})
+		load.add([]peer.ID{first})
+
+		strategy := leastLoadedStrategy{load: load}
+		require.True(t, strategy.Collect())
+		require.Equal(t, []peer.ID{third, second}, strategy.Select(candidates, 2))
+
+		// Once the work is done, peers are back to even.
+		load.done([]peer.ID{first, second})
+		load.done([]peer.ID{first})
+		require.Equal(t, []peer.ID{first, second}, strategy.Select(candidates, 2))
+
+		// Reported capacity takes precedence.
+		reported := []Candidate{
+			{ID: first, Capacity: &execute.Capacity{Executions: 2}},
+			{ID: second, Capacity: &execute.Capacity{Executions: 1, Queued: 3}},
+			{ID: third, Capacity: &execute.Capacity{Executions: 1}},
+		}
+		require.Equal(t, []peer.ID{third, first}, strategy.Select(reported, 2))
+	})
+	t.Run("lowest latency", func(t *testing.T) {
+		t.Parallel()
+
+		latencies := map[peer.ID]time.Duration{
+			first:  50 * time.Millisecond,
+			second: 5 * time.Millisecond,
+			// Latency to third peer unknown - response time is used instead.
+		}
+
+		strategy := lowestLatencyStrategy{
+			latency: func(id peer.ID) time.Duration {
+				return latencies[id]
+			},
+		}
+		require.True(t, strategy.Collect())
+		require.Equal(t, []peer.ID{second, third}, strategy.Select(candidates, 2))
+	})
+}
+
+func TestHead_SelectionStrategy(t *testing.T) {
+
+	head := createHeadNode(t)
+
+	require.IsType(t, firstComeStrategy{}, head.selectionStrategy(""))
+	require.IsType(t, randomStrategy{}, head.selectionStrategy(execute.SelectionRandom))
+	require.IsType(t, leastLoadedStrategy{}, head.selectionStrategy(execute.SelectionLeastLoaded))
+	require.IsType(t, lowestLatencyStrategy{}, head.selectionStrategy(execute.SelectionLowestLatency))
+
+	// Unknown strategies fall back to the default one.
+	require.IsType(t, firstComeStrategy{}, head.selectionStrategy("dummy-strategy"))
+}
@@ -0,0 +1,156 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/response"
+)
+
+// StateTopic returns the topic head nodes use to share execution request state, for head nodes on the given topic.
+func StateTopic(topic string) string {
+	return topic + stateTopicSuffix
+}
+
+// shareState publishes the execution record to other head nodes, if we share request state.
+func (h *HeadNode) shareState(ctx context.Context, record bls.ExecutionRecord) {
+
+	msg := response.ExecutionState{
+		Record: record,
+	}
+
+	for _, topic := range h.cfg.StateTopics {
+		err := h.PublishToTopic(ctx, topic, &msg)
+		if err != nil {
+			h.Log().Warn().Err(err).Str("topic", topic).Str("request", record.RequestID).Msg("could not share execution request state")
+		}
+	}
+}
+
+// processExecutionState saves the execution request state shared by another head node.
+func (h *HeadNode) processExecutionState(ctx context.Context, from peer.ID, msg response.ExecutionState) error {
+
+	if len(h.cfg.StateTopics) == 0 {
+		h.Log().Debug().Stringer("from", from).Msg("not sharing request state, skipping execution state message")
+		return nil
+	}
+
+	// Pubsub messages are signed by the peer publishing them, so the sender is authenticated.
+	// Only accept state from head nodes we trust - anyone can publish on the state topics.
+	if !slices.Contains(h.cfg.StateHeads, from) {
+		h.Log().Warn().Stringer("from", from).Msg("execution state shared by an untrusted peer, dropping")
+		return nil
+	}
+
+	record := msg.Record
+	if record.RequestID == "" {
+		return errors.New("execution state is missing request ID")
+	}
+
+	// Only the head node handling the request can report on it.
+	record.Head = from
+
+	existing, ok := h.results.get(ctx, record.RequestID)
+	if ok && existing.Head != from {
+		h.Log().Warn().
+			Str("request", record.RequestID).
+			Stringer("from", from).
+			Stringer("head", existing.Head).
+			Msg("execution state shared by a node not handling the request, dropping")
+		return nil
+	}
+
+	// Messages might arrive out of order - don't overwrite the final state of the request.
+	if ok && existing.Status.Final() && !record.Status.Final() {
+		return nil
+	}
+
+	h.Log().Debug().
+		Str("request", record.RequestID).
+		Stringer("head", from).
+		Str("status", string(record.Status)).
+		Msg("received execution state from head node")
+
+	return h.results.save(ctx, record)
+}
+
+// markOrphaned records that the executions this node was running will never complete.
+// Returns the updated execution records.
+func (h *HeadNode) markOrphaned(ctx context.Context, records []bls.ExecutionRecord) []bls.ExecutionRecord {
+
+	var orphaned []bls.ExecutionRecord
+	for _, record := range records {
+
+		// Leave executions handled by other head nodes as they are - the head node handling the request reports its final state.
+		if record.Head != h.Host().ID() {
+			continue
+		}
+
+		h.Log().Warn().Str("request", record.RequestID).Str("status", string(record.Status)).Msg("execution was in progress when the node stopped, marking as orphaned")
+
+		record.Status = execute.StatusOrphaned
+		record.Code = codes.Error
+		record.Message = bls.ErrExecutionOrphaned.Error()
+		record.CompletedAt = time.Now()
+
+		err := h.results.save(ctx, record)
+		if err != nil {
+			h.Log().Error().Err(err).Str("request", record.RequestID).Msg("could not save orphaned execution record")
+		}
+
+		orphaned = append(orphaned, record)
+	}
+
+	return orphaned
+}
+
+// shareOrphaned shares the final state of orphaned executions with other head nodes.
+// Orphaned executions are found on startup, before we're connected to anyone, so we wait until there are peers on the state topics.
+func (h *HeadNode) shareOrphaned(ctx context.Context, records []bls.ExecutionRecord) {
+
+	ticker := time.NewTicker(orphanedShareInterval)
+	defer ticker.Stop()
+
+	timeout := time.NewTimer(orphanedShareTimeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !h.haveStatePeers() {
+				continue
+			}
+
+			for _, record := range records {
+				h.shareState(ctx, record)
+			}
+
+			return
+
+		case <-timeout.C:
+			h.Log().Warn().Int("count", len(records)).Msg("no head nodes to share orphaned executions with")
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (h *HeadNode) haveStatePeers() bool {
+
+	for _, topic := range h.cfg.StateTopics {
+		if len(h.TopicPeers(topic)) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
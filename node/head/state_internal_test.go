@@ -0,0 +1,218 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/store"
+	"github.com/Maelkum/b7s/store/codec"
+	"github.com/Maelkum/b7s/testing/helpers"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestHead_ExecutionState(t *testing.T) {
+
+	var (
+		ctx = context.Background()
+
+		owner     = mocks.GenericPeerIDs[0]
+		other     = mocks.GenericPeerIDs[1]
+		untrusted = mocks.GenericPeerIDs[2]
+	)
+
+	createSharingHead := func(t *testing.T) *HeadNode {
+		t.Helper()
+
+		db := helpers.InMemoryDB(t)
+		t.Cleanup(func() { db.Close() })
+
+		head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()), ShareState(StateTopic(bls.DefaultTopic)), StateHeads(owner, other))
+		require.NoError(t, err)
+
+		return head
+	}
+
+	pending := createExecutionRecord(t, "request-id", time.Time{})
+	pending.StartedAt = time.Now()
+	pending.Status = execute.StatusExecuting
+
+	done := createExecutionRecord(t, "request-id", time.Now())
+	done.Status = execute.StatusDone
+
+	t.Run("shared state is saved", func(t *testing.T) {
+		t.Parallel()
+
+		head := createSharingHead(t)
+
+		err := head.processExecutionState(ctx, owner, response.ExecutionState{Record: pending})
+		require.NoError(t, err)
+
+		status, _, ok := head.ExecutionStatus(ctx, pending.RequestID)
+		require.True(t, ok)
+		require.Equal(t, execute.StatusExecuting, status)
+
+		// Result is not available until the execution is done.
+		_, ok = head.ExecutionResult(ctx, pending.RequestID)
+		require.False(t, ok)
+
+		err = head.processExecutionState(ctx, owner, response.ExecutionState{Record: done})
+		require.NoError(t, err)
+
+		record, ok := head.ExecutionResult(ctx, done.RequestID)
+		require.True(t, ok)
+		require.Equal(t, execute.StatusDone, record.Status)
+		require.Equal(t, owner, record.Head)
+		require.Equal(t, done.Results, record.Results)
+	})
+	t.Run("only the head handling the request can update it", func(t *testing.T) {
+		t.Parallel()
+
+		head := createSharingHead(t)
+
+		err := head.processExecutionState(ctx, owner, response.ExecutionState{Record: pending})
+		require.NoError(t, err)
+
+		err = head.processExecutionState(ctx, other, response.ExecutionState{Record: done})
+		require.NoError(t, err)
+
+		status, _, ok := head.ExecutionStatus(ctx, pending.RequestID)
+		require.True(t, ok)
+		require.Equal(t, execute.StatusExecuting, status)
+	})
+	t.Run("final state is not overwritten", func(t *testing.T) {
+		t.Parallel()
+
+		head := createSharingHead(t)
+
+		err := head.processExecutionState(ctx, owner, response.ExecutionState{Record: done})
+		require.NoError(t, err)
+
+		err = head.processExecutionState(ctx, owner, response.ExecutionState{Record: pending})
+		require.NoError(t, err)
+
+		status, _, ok := head.ExecutionStatus(ctx, done.RequestID)
+		require.True(t, ok)
+		require.Equal(t, execute.StatusDone, status)
+	})
+	t.Run("state shared by untrusted peers is dropped", func(t *testing.T) {
+		t.Parallel()
+
+		head := createSharingHead(t)
+
+		err := head.processExecutionState(ctx, untrusted, response.ExecutionState{Record: done})
+		require.NoError(t, err)
+
+		_, _, ok := head.ExecutionStatus(ctx, done.RequestID)
+		require.False(t, ok)
+	})
+	t.Run("state is ignored when not sharing", func(t *testing.T) {
+		t.Parallel()
+
+		head := createHeadNode(t)
+
+		err := head.processExecutionState(ctx, owner, response.ExecutionState{Record: done})
+		require.NoError(t, err)
+
+		_, ok := head.ExecutionResult(ctx, done.RequestID)
+		require.False(t, ok)
+	})
+}
+
+func TestHead_OrphanedExecutions(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	var (
+		core  = mocks.BaselineNodeCore(t)
+		store = store.New(db, codec.NewJSONCodec())
+		self  = core.Host().ID()
+	)
+
+	// Execution this head node was running when it stopped.
+	own := createExecutionRecord(t, "own-request-id", time.Time{})
+	own.StartedAt = time.Now()
+	own.Status = execute.StatusExecuting
+	own.Head = self
+	require.NoError(t, store.SaveExecution(ctx, own))
+
+	// Execution handled by another head node.
+	shared := createExecutionRecord(t, "shared-request-id", time.Time{})
+	shared.StartedAt = time.Now()
+	shared.Status = execute.StatusExecuting
+	shared.Head = mocks.GenericPeerID
+	require.NoError(t, store.SaveExecution(ctx, shared))
+
+	head, err := New(core, store)
+	require.NoError(t, err)
+
+	require.NoError(t, head.Run(ctx))
+
+	record, ok := head.ExecutionResult(ctx, own.RequestID)
+	require.True(t, ok)
+	require.Equal(t, execute.StatusOrphaned, record.Status)
+	require.Equal(t, bls.ErrExecutionOrphaned.Error(), record.Message)
+
+	status, _, ok := head.ExecutionStatus(ctx, shared.RequestID)
+	require.True(t, ok)
+	require.Equal(t, execute.StatusExecuting, status)
+}
+
+func TestHead_OrphanedExecutionsShared(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	var (
+		core  = mocks.BaselineNodeCore(t)
+		store = store.New(db, codec.NewJSONCodec())
+		topic = StateTopic(bls.DefaultTopic)
+
+		shared = make(chan bls.ExecutionRecord, 1)
+	)
+
+	own := createExecutionRecord(t, "own-request-id", time.Time{})
+	own.StartedAt = time.Now()
+	own.Status = execute.StatusExecuting
+	own.Head = core.Host().ID()
+	require.NoError(t, store.SaveExecution(ctx, own))
+
+	core.TopicPeersFunc = func(string) []peer.ID {
+		return []peer.ID{mocks.GenericPeerID}
+	}
+	core.PublishToTopicFunc = func(_ context.Context, to string, msg bls.Message) error {
+		require.Equal(t, topic, to)
+
+		state, ok := msg.(*response.ExecutionState)
+		require.True(t, ok)
+
+		shared <- state.Record
+		return nil
+	}
+
+	head, err := New(core, store, ShareState(topic), StateHeads(mocks.GenericPeerID))
+	require.NoError(t, err)
+
+	require.NoError(t, head.Run(ctx))
+
+	select {
+	case record := <-shared:
+		require.Equal(t, own.RequestID, record.RequestID)
+		require.Equal(t, execute.StatusOrphaned, record.Status)
+	case <-time.After(5 * orphanedShareInterval):
+		t.Fatal("orphaned execution state was not shared")
+	}
+}
@@ -10,8 +10,15 @@ const (
 )
 
 var (
-	rollCallsPublishedMetric = []string{"node", "rollcalls", "published"}
-	executionsMetric         = []string{"node", "function", "executions"}
+	rollCallsPublishedMetric      = []string{"node", "rollcalls", "published"}
+	executionsMetric              = []string{"node", "function", "executions"}
+	executionsRejectedMetric      = []string{"node", "function", "executions", "rejected"}
+	executionQueueMetric          = []string{"node", "function", "executions", "queued"}
+	scheduledExecutionsMetric     = []string{"node", "function", "executions", "scheduled"}
+	invalidResultSignaturesMetric = []string{"node", "function", "results", "invalid_signature"}
+	unsignedResultsMetric         = []string{"node", "function", "results", "unsigned"}
+	resultCacheHitsMetric         = []string{"node", "function", "results", "cache", "hits"}
+	resultCacheMissesMetric       = []string{"node", "function", "results", "cache", "misses"}
 )
 
 var Counters = []prometheus.CounterDefinition{
@@ -23,4 +30,35 @@ var Counters = []prometheus.CounterDefinition{
 		Name: executionsMetric,
 		Help: "Number of function executions.",
 	},
+	{
+		Name: executionsRejectedMetric,
+		Help: "Number of execution requests rejected by admission control.",
+	},
+	{
+		Name: scheduledExecutionsMetric,
+		Help: "Number of scheduled function executions.",
+	},
+	{
+		Name: invalidResultSignaturesMetric,
+		Help: "Number of execution results dropped because of an invalid signature.",
+	},
+	{
+		Name: unsignedResultsMetric,
+		Help: "Number of unsigned execution results accepted from worker nodes.",
+	},
+	{
+		Name: resultCacheHitsMetric,
+		Help: "Number of execution requests served from the result cache.",
+	},
+	{
+		Name: resultCacheMissesMetric,
+		Help: "Number of execution requests for cacheable functions that had to be executed.",
+	},
+}
+
+var Gauges = []prometheus.GaugeDefinition{
+	{
+		Name: executionQueueMetric,
+		Help: "Number of execution requests waiting for an execution slot.",
+	},
 }
@@ -0,0 +1,25 @@
+package head
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// markWorker records that the peer is a worker node.
+func (h *HeadNode) markWorker(id peer.ID) {
+	h.workers.Set(id, struct{}{})
+}
+
+// knownWorkers returns the peers from the list that are known to be worker nodes - peers that answered messages only workers answer.
+// Other head nodes subscribe to the same topics but never answer these messages, so they should not be waited on.
+func (h *HeadNode) knownWorkers(peers []peer.ID) []peer.ID {
+
+	workers := make([]peer.ID, 0, len(peers))
+	for _, id := range peers {
+		_, ok := h.workers.Get(id)
+		if ok {
+			workers = append(workers, id)
+		}
+	}
+
+	return workers
+}
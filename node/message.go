@@ -178,6 +178,17 @@ func (c *core) JoinTopic(topic string) error {
 	return nil
 }
 
+// TopicPeers returns the peers subscribed to the given topic, as known to this node.
+func (c *core) TopicPeers(topic string) []peer.ID {
+
+	topicInfo, ok := c.topics.Get(topic)
+	if !ok || topicInfo.handle == nil {
+		return nil
+	}
+
+	return topicInfo.handle.ListPeers()
+}
+
 func (c *core) Connected(peer peer.ID) bool {
 	connections := c.host.Network().ConnsToPeer(peer)
 	return len(connections) > 0
@@ -54,14 +54,12 @@ func correctPipeline(msg string, pipeline Pipeline) bool {
 		// Messages we don't expect as direct messages.
 		case
 			bls.MessageHealthCheck,
-			bls.MessageRollCall:
-
-			// Technically we only publish InstallFunction. However, it's handy for tests to support
-			// direct install, and it's somewhat of a low risk.
+			bls.MessageExecutionState:
 
 			return false
 
 		default:
+			// Roll calls are sent directly when the execution request targets specific peers.
 			return true
 		}
 	}
@@ -70,11 +68,15 @@ func correctPipeline(msg string, pipeline Pipeline) bool {
 	// Messages we don't allow to be published.
 	case
 		bls.MessageInstallFunctionResponse,
+		bls.MessageUninstallFunctionResponse,
 		bls.MessageExecute,
 		bls.MessageExecuteResponse,
 		bls.MessageFormCluster,
 		bls.MessageFormClusterResponse,
 		bls.MessageDisbandCluster,
+		bls.MessageCancelExecution,
+		bls.MessageCancelExecutionResponse,
+		bls.MessageFunctionInventoryResponse,
 		bls.MessageRollCallResponse:
 
 		return false
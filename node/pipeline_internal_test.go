@@ -28,7 +28,6 @@ func TestNode_DisallowedMessages(t *testing.T) {
 		{pubsub, bls.MessageDisbandCluster},
 		// Messages disallowed for direct sending.
 		{direct, bls.MessageHealthCheck},
-		{direct, bls.MessageRollCall},
 	}
 
 	for _, test := range tests {
@@ -36,3 +35,27 @@ func TestNode_DisallowedMessages(t *testing.T) {
 		require.False(t, ok, "message: %s, pipeline: %s", test.message, test.pipeline)
 	}
 }
+
+func TestNode_AllowedMessages(t *testing.T) {
+
+	var (
+		pubsub = PubSubPipeline(bls.DefaultTopic)
+		direct = DirectMessagePipeline
+	)
+
+	tests := []struct {
+		pipeline Pipeline
+		message  string
+	}{
+		{pubsub, bls.MessageRollCall},
+		{pubsub, bls.MessageInstallFunction},
+		// Roll calls are sent directly to peers targeted by the execution request.
+		{direct, bls.MessageRollCall},
+		{direct, bls.MessageRollCallResponse},
+	}
+
+	for _, test := range tests {
+		ok := correctPipeline(test.message, test.pipeline)
+		require.True(t, ok, "message: %s, pipeline: %s", test.message, test.pipeline)
+	}
+}
@@ -59,7 +59,6 @@ func (c *core) Run(ctx context.Context, process func(context.Context, peer.ID, s
 	var (
 		workers sync.WaitGroup
 		wg      sync.WaitGroup
-		sema    chan struct{} = make(chan struct{}, c.cfg.Concurrency)
 	)
 
 	// Process topic messages - spin up a goroutine for each topic that will feed the main processing loop below.
@@ -96,13 +95,15 @@ func (c *core) Run(ctx context.Context, process func(context.Context, peer.ID, s
 					Hex("id", []byte(msg.ID)).Msg("received message")
 
 				// Try to get a slot for processing the request.
-				sema <- struct{}{}
+				c.queued.Add(1)
+				c.sema <- struct{}{}
+				c.queued.Add(-1)
 				wg.Add(1)
 
 				go func(msg *pubsub.Message) {
 					// Free up slot after we're done.
 					defer wg.Done()
-					defer func() { <-sema }()
+					defer func() { <-c.sema }()
 
 					c.metrics.IncrCounterWithLabels(topicMessagesMetric, 1, []metrics.Label{{Name: "topic", Value: name}})
 
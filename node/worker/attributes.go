@@ -1,10 +1,15 @@
 package worker
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/ipfs/boxo/ipns"
 
 	"github.com/libp2p/go-libp2p/core/crypto"
@@ -17,23 +22,98 @@ import (
 
 const (
 	defaultAttributesFilename = "attributes.bin"
+
+	attributesFetchTimeout = 30 * time.Second
 )
 
-func loadAttributes(key crypto.PubKey) (attributes.Attestation, error) {
+// loadAttributes loads the attestation of the node, either from the local file or from one of the IPFS gateways.
+// The attestation is verified before it is returned.
+func (w *Worker) loadAttributes(ctx context.Context) (attributes.Attestation, error) {
+
+	var (
+		att attributes.Attestation
+		err error
+	)
+	if w.cfg.AttributesPath != "" {
+		att, err = readAttributes(w.cfg.AttributesPath)
+	} else {
+		att, err = fetchAttributes(ctx, w.Host().PublicKey(), w.cfg.AttributeGateways)
+	}
+	if err != nil {
+		return attributes.Attestation{}, err
+	}
+
+	err = verifyAttestation(att, w.Host().ID())
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("invalid attestation: %w", err)
+	}
+
+	return att, nil
+}
+
+func readAttributes(path string) (attributes.Attestation, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not open attribute file: %w", err)
+	}
+	defer f.Close()
+
+	att, err := attributes.ImportAttestation(f)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not load attestation from file: %w", err)
+	}
+
+	return att, nil
+}
+
+// fetchAttributes tries to retrieve the attestation from the given gateways, in order, returning the first one successfully retrieved.
+func fetchAttributes(ctx context.Context, key crypto.PubKey, gateways []string) (attributes.Attestation, error) {
 
 	name, err := getAttributesIPNSName(key)
 	if err != nil {
 		return attributes.Attestation{}, fmt.Errorf("could not get name from key: %w", err)
 	}
 
-	attributeURL := ipnsGatewayURL(name)
+	var errs *multierror.Error
+	for _, gateway := range gateways {
+
+		att, err := fetchAttributesFromGateway(ctx, ipnsGatewayURL(gateway, name))
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("gateway %v: %w", gateway, err))
+			continue
+		}
+
+		return att, nil
+	}
+
+	if errs == nil {
+		return attributes.Attestation{}, errors.New("no gateways configured")
+	}
+
+	return attributes.Attestation{}, fmt.Errorf("could not get attribute file from any gateway: %w", errs)
+}
+
+func fetchAttributesFromGateway(ctx context.Context, attributeURL string) (attributes.Attestation, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, attributesFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attributeURL, nil)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not create request: %w", err)
+	}
 
-	res, err := http.Get(attributeURL)
+	res, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return attributes.Attestation{}, fmt.Errorf("could not get attribute file from URL: %w", err)
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode != http.StatusOK {
+		return attributes.Attestation{}, fmt.Errorf("unexpected response status: %v", res.Status)
+	}
+
 	att, err := attributes.ImportAttestation(res.Body)
 	if err != nil {
 		return attributes.Attestation{}, fmt.Errorf("could not load attestation from file: %w", err)
@@ -53,8 +133,38 @@ func getAttributesIPNSName(key crypto.PubKey) (string, error) {
 	return name.String(), nil
 }
 
-func ipnsGatewayURL(name string) string {
-	return fmt.Sprintf("https://%s.ipns.cf-ipfs.com/%s", name, defaultAttributesFilename)
+func ipnsGatewayURL(gateway string, name string) string {
+	return fmt.Sprintf("%s/ipns/%s/%s", strings.TrimSuffix(gateway, "/"), name, defaultAttributesFilename)
+}
+
+// verifyAttestation checks that the attestation signatures are valid and that the attestation is about the given node.
+func verifyAttestation(att attributes.Attestation, id peer.ID) error {
+
+	err := verifyAttestationSubject(att, id)
+	if err != nil {
+		return err
+	}
+
+	err = attributes.Validate(att)
+	if err != nil {
+		return fmt.Errorf("could not validate attestation: %w", err)
+	}
+
+	return nil
+}
+
+// verifyAttestationSubject checks that the attestation was signed by the given node.
+func verifyAttestationSubject(att attributes.Attestation, id peer.ID) error {
+
+	if att.Signature == nil {
+		return errors.New("attestation is not signed")
+	}
+
+	if att.Signature.Signer != id {
+		return fmt.Errorf("attestation subject does not match node ID (subject: %s, node: %s)", att.Signature.Signer.String(), id.String())
+	}
+
+	return nil
 }
 
 func haveAttributes(have attributes.Attestation, want execute.Attributes) error {
@@ -99,7 +209,7 @@ func haveAttributes(have attributes.Attestation, want execute.Attributes) error
 
 	// It doesn't make a lot of sense to require attestors without wanting specific attributes,
 	// but if that's the case, and there's no attributes wanted, we're done now.
-	if len(want.Values) == 0 {
+	if len(want.Values) == 0 && len(want.Requirements) == 0 {
 		return nil
 	}
 
@@ -120,5 +230,56 @@ func haveAttributes(have attributes.Attestation, want execute.Attributes) error
 		}
 	}
 
+	for _, requirement := range want.Requirements {
+
+		value, ok := attrs[requirement.Name]
+		if !ok {
+			return fmt.Errorf("attribute wanted but not found (attr: %v, operator: %v)", requirement.Name, requirement.Operator)
+		}
+
+		match, err := requirement.Match(value)
+		if err != nil {
+			return fmt.Errorf("could not check attribute requirement (attr: %v, operator: %v): %w", requirement.Name, requirement.Operator, err)
+		}
+
+		if !match {
+			return fmt.Errorf("attribute value doesn't satisfy requirement (attr: %v, operator: %v, want: %v, have: %v)", requirement.Name, requirement.Operator, requirementValue(requirement), value)
+		}
+	}
+
 	return nil
 }
+
+func requirementValue(requirement execute.AttributeRequirement) string {
+
+	if requirement.Operator == execute.AttributeIn {
+		return strings.Join(requirement.Values, ",")
+	}
+
+	return requirement.Value
+}
+
+// runAttributesRefreshLoop periodically reloads the node attestation. If the attestation cannot be loaded, the previous one is kept.
+func (w *Worker) runAttributesRefreshLoop(ctx context.Context) {
+
+	ticker := time.NewTicker(w.cfg.AttributesRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			att, err := w.loadAttributes(ctx)
+			if err != nil {
+				w.Log().Warn().Err(err).Msg("could not refresh attributes")
+				continue
+			}
+
+			w.attributes.Store(&att)
+
+			w.Log().Debug().Any("attributes", att).Msg("node attributes refreshed")
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
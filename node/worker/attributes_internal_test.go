@@ -0,0 +1,255 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestWorker_HaveAttributes(t *testing.T) {
+
+	have := attributes.Attestation{
+		Attributes: []attributes.Attribute{
+			{Name: "region", Value: "eu-west"},
+			{Name: "ram", Value: "32"},
+			{Name: "gpu", Value: "nvidia-a100"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		want        execute.Attributes
+		errExpected bool
+	}{
+		{
+			name: "plain values are matched exactly",
+			want: execute.Attributes{Values: []execute.Parameter{{Name: "region", Value: "eu-west"}}},
+		},
+		{
+			name:        "plain value mismatch",
+			want:        execute.Attributes{Values: []execute.Parameter{{Name: "region", Value: "eu-central"}}},
+			errExpected: true,
+		},
+		{
+			name: "all requirements satisfied",
+			want: execute.Attributes{
+				Requirements: []execute.AttributeRequirement{
+					{Name: "region", Operator: execute.AttributeIn, Values: []string{"eu-west", "eu-central"}},
+					{Name: "ram", Operator: execute.AttributeGreaterOrEqual, Value: "16"},
+					{Name: "ram", Operator: execute.AttributeLess, Value: "64"},
+					{Name: "gpu", Operator: execute.AttributePrefix, Value: "nvidia-"},
+					{Name: "gpu", Operator: execute.AttributeRegex, Value: "^nvidia-a[0-9]+$"},
+					{Name: "region", Operator: execute.AttributeNotEqual, Value: "us-east"},
+				},
+			},
+		},
+		{
+			name: "numeric requirement not satisfied",
+			want: execute.Attributes{
+				Requirements: []execute.AttributeRequirement{
+					{Name: "ram", Operator: execute.AttributeGreater, Value: "32"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "attribute value not numeric",
+			want: execute.Attributes{
+				Requirements: []execute.AttributeRequirement{
+					{Name: "region", Operator: execute.AttributeLessOrEqual, Value: "10"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "required attribute missing",
+			want: execute.Attributes{
+				Requirements: []execute.AttributeRequirement{
+					{Name: "cpu", Operator: execute.AttributeEqual, Value: "x86"},
+				},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := haveAttributes(have, test.want)
+			if test.errExpected {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWorker_LoadAttributes(t *testing.T) {
+
+	attrs := []attributes.Attribute{
+		{Name: "region", Value: "eu-west"},
+		{Name: "ram", Value: "32"},
+	}
+
+	t.Run("local file", func(t *testing.T) {
+		t.Parallel()
+
+		core := mocks.BaselineNodeCore(t)
+		data := exportAttestation(t, attrs, core.Host().PrivateKey())
+
+		path := filepath.Join(t.TempDir(), "attributes.bin")
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		worker, err := New(core, mocks.BaselineFStore(t), mocks.BaselineExecutor(t),
+			Workspace(t.TempDir()),
+			AttributeLoading(true),
+			AttributesPath(path),
+		)
+		require.NoError(t, err)
+
+		att := worker.attributes.Load()
+		require.NotNil(t, att)
+		require.Equal(t, attrs, att.Attributes)
+		require.Equal(t, core.Host().ID(), att.Signature.Signer)
+	})
+	t.Run("gateways are tried in order", func(t *testing.T) {
+		t.Parallel()
+
+		core := mocks.BaselineNodeCore(t)
+		data := exportAttestation(t, attrs, core.Host().PrivateKey())
+
+		name, err := getAttributesIPNSName(core.Host().PublicKey())
+		require.NoError(t, err)
+
+		failing := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(failing.Close)
+
+		gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/ipns/"+name+"/"+defaultAttributesFilename {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write(data)
+		}))
+		t.Cleanup(gateway.Close)
+
+		worker, err := New(core, mocks.BaselineFStore(t), mocks.BaselineExecutor(t),
+			Workspace(t.TempDir()),
+			AttributeLoading(true),
+			AttributeGateways([]string{failing.URL, gateway.URL + "/"}),
+		)
+		require.NoError(t, err)
+
+		att := worker.attributes.Load()
+		require.NotNil(t, att)
+		require.Equal(t, attrs, att.Attributes)
+	})
+	t.Run("all gateways fail", func(t *testing.T) {
+		t.Parallel()
+
+		failing := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(failing.Close)
+
+		_, err := New(mocks.BaselineNodeCore(t), mocks.BaselineFStore(t), mocks.BaselineExecutor(t),
+			Workspace(t.TempDir()),
+			AttributeLoading(true),
+			AttributeGateways([]string{failing.URL}),
+		)
+		require.Error(t, err)
+	})
+	t.Run("attestation for a different node", func(t *testing.T) {
+		t.Parallel()
+
+		key, _, err := crypto.GenerateEd25519Key(nil)
+		require.NoError(t, err)
+
+		data := exportAttestation(t, attrs, key)
+
+		path := filepath.Join(t.TempDir(), "attributes.bin")
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		_, err = New(mocks.BaselineNodeCore(t), mocks.BaselineFStore(t), mocks.BaselineExecutor(t),
+			Workspace(t.TempDir()),
+			AttributeLoading(true),
+			AttributesPath(path),
+		)
+		require.Error(t, err)
+	})
+	t.Run("attributes are refreshed", func(t *testing.T) {
+		t.Parallel()
+
+		core := mocks.BaselineNodeCore(t)
+		path := filepath.Join(t.TempDir(), "attributes.bin")
+		require.NoError(t, os.WriteFile(path, exportAttestation(t, attrs, core.Host().PrivateKey()), 0o600))
+
+		worker, err := New(core, mocks.BaselineFStore(t), mocks.BaselineExecutor(t),
+			Workspace(t.TempDir()),
+			AttributeLoading(true),
+			AttributesPath(path),
+			AttributesRefreshInterval(10*time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		updated := []attributes.Attribute{{Name: "region", Value: "eu-central"}}
+		require.NoError(t, os.WriteFile(path, exportAttestation(t, updated, core.Host().PrivateKey()), 0o600))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		go worker.runAttributesRefreshLoop(ctx)
+
+		require.Eventually(t, func() bool {
+			return worker.attributes.Load().Attributes[0].Value == "eu-central"
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestWorker_VerifyAttestationSubject(t *testing.T) {
+
+	key, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	require.Error(t, verifyAttestationSubject(attributes.Attestation{}, id))
+	require.Error(t, verifyAttestationSubject(attributes.Attestation{Signature: &attributes.Signature{Signer: mocks.GenericPeerID}}, id))
+	require.NoError(t, verifyAttestationSubject(attributes.Attestation{Signature: &attributes.Signature{Signer: id}}, id))
+}
+
+func exportAttestation(t *testing.T, attrs []attributes.Attribute, key crypto.PrivKey) []byte {
+	t.Helper()
+
+	sig, err := attributes.SignAttributes(attrs, key)
+	require.NoError(t, err)
+
+	signer, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	att := attributes.Attestation{
+		Attributes: attrs,
+		Signature: &attributes.Signature{
+			Signer:    signer,
+			Signature: sig,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, attributes.ExportAttestation(&buf, att))
+
+	return buf.Bytes()
+}
@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/node/internal/syncmap"
+)
+
+// cancellableExecutor wraps the executor and keeps track of running executions so they can be cancelled.
+// Executions hold the lock of the function they run, so the function cannot be removed while it's being executed.
+type cancellableExecutor struct {
+	bls.Executor
+	running   *syncmap.Map[string, runningExecution]
+	functions *functionLocks
+}
+
+// runningExecution describes an execution in progress.
+type runningExecution struct {
+	functionID string
+	cancel     context.CancelFunc
+}
+
+func (e *cancellableExecutor) ExecuteFunction(ctx context.Context, requestID string, req execute.Request) (execute.Result, error) {
+
+	unlock := e.functions.rlock(req.FunctionID)
+	defer unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	e.running.Set(requestID, runningExecution{functionID: req.FunctionID, cancel: cancel})
+	defer e.running.Delete(requestID)
+
+	return e.Executor.ExecuteFunction(ctx, requestID, req)
+}
+
+// cancelExecution stops the execution of the given request. Returns true if the execution was running.
+func (w *Worker) cancelExecution(requestID string) bool {
+
+	execution, ok := w.executions.Get(requestID)
+	if !ok {
+		return false
+	}
+
+	execution.cancel()
+	return true
+}
+
+func (w *Worker) processCancelExecution(ctx context.Context, from peer.ID, req request.CancelExecution) error {
+
+	log := w.Log().With().Stringer("peer", from).Str("request", req.RequestID).Logger()
+
+	log.Info().Msg("received request to cancel execution")
+
+	// Only the node that requested the execution may cancel it.
+	origin, ok := w.origins.Get(req.RequestID)
+	if !ok {
+		return w.Send(ctx, from, req.Response(codes.NotFound))
+	}
+	if origin != from {
+		log.Warn().Stringer("origin", origin).Msg("execution cancellation requested by a node other than the one that requested execution")
+		return w.Send(ctx, from, req.Response(codes.NotPermitted))
+	}
+
+	cancelled := w.cancelExecution(req.RequestID)
+
+	// If we're part of a cluster for this request - leave it.
+	_, clustered := w.clusters.Get(req.RequestID)
+	if clustered {
+		err := w.leaveCluster(req.RequestID, 0)
+		if err != nil {
+			log.Error().Err(err).Msg("could not leave cluster")
+			return w.Send(ctx, from, req.Response(codes.Error))
+		}
+	}
+
+	if !cancelled && !clustered {
+		return w.Send(ctx, from, req.Response(codes.NotFound))
+	}
+
+	log.Info().Bool("cancelled", cancelled).Bool("left_cluster", clustered).Msg("execution cancelled")
+
+	return w.Send(ctx, from, req.Response(codes.OK))
+}
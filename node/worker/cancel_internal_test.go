@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessCancelExecution(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+	)
+
+	var (
+		origin = mocks.GenericPeerIDs[0]
+		other  = mocks.GenericPeerIDs[1]
+	)
+
+	// Create executor that runs until cancelled.
+	started := make(chan struct{})
+	executor := mocks.BaselineExecutor(t)
+	executor.ExecFunctionFunc = func(ctx context.Context, _ string, _ execute.Request) (execute.Result, error) {
+		close(started)
+		<-ctx.Done()
+		return execute.Result{Code: codes.Error}, ctx.Err()
+	}
+
+	// Record cancellation responses.
+	cancelResponses := make(chan response.CancelExecution, 10)
+	core := mocks.BaselineNodeCore(t)
+	core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+		res, ok := any(msg).(*response.CancelExecution)
+		if ok {
+			cancelResponses <- *res
+		}
+		return nil
+	}
+
+	worker, err := New(core, mocks.BaselineFStore(t), executor, Workspace(t.TempDir()))
+	require.NoError(t, err)
+
+	cancel := request.CancelExecution{RequestID: requestID}
+
+	// Unknown request.
+	err = worker.processCancelExecution(context.Background(), origin, cancel)
+	require.NoError(t, err)
+	require.Equal(t, codes.NotFound, (<-cancelResponses).Code)
+
+	// Start execution.
+	done := make(chan error)
+	go func() {
+		done <- worker.processWorkOrder(context.Background(), origin, request.WorkOrder{
+			RequestID: requestID,
+			Request:   mocks.GenericExecutionRequest,
+		})
+	}()
+
+	<-started
+
+	// Only the node that requested execution may cancel it.
+	err = worker.processCancelExecution(context.Background(), other, cancel)
+	require.NoError(t, err)
+	require.Equal(t, codes.NotPermitted, (<-cancelResponses).Code)
+
+	err = worker.processCancelExecution(context.Background(), origin, cancel)
+	require.NoError(t, err)
+	require.Equal(t, codes.OK, (<-cancelResponses).Code)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("execution was not cancelled")
+	}
+
+	_, ok := worker.executions.Get(requestID)
+	require.False(t, ok)
+	_, ok = worker.origins.Get(requestID)
+	require.False(t, ok)
+}
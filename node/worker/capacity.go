@@ -0,0 +1,23 @@
+package worker
+
+import (
+	"github.com/Maelkum/b7s/models/execute"
+)
+
+// capacity describes how much work the node can take on. `cached` denotes whether the function
+// was already installed, or we had to download it.
+func (w *Worker) capacity(cached bool) execute.Capacity {
+
+	load := w.Load()
+
+	capacity := execute.Capacity{
+		Slots:      load.Limit,
+		FreeSlots:  load.Limit - min(load.Active, load.Limit),
+		Queued:     load.Queued,
+		Executions: uint(len(w.executions.Keys())),
+		Clusters:   uint(len(w.clusters.Keys())),
+		Cached:     cached,
+	}
+
+	return capacity
+}
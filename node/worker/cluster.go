@@ -41,15 +41,25 @@ func (w *Worker) processFormCluster(ctx context.Context, from peer.ID, req reque
 		w.Host().Network().Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, ClusterAddressTTL)
 	}
 
+	var err error
 	switch req.Consensus {
 	case consensus.Raft:
-		return w.createRaftCluster(ctx, from, req)
+		err = w.createRaftCluster(ctx, from, req)
 
 	case consensus.PBFT:
-		return w.createPBFTCluster(ctx, from, req)
+		err = w.createPBFTCluster(ctx, from, req)
+
+	default:
+		return fmt.Errorf("invalid consensus specified (%v %s)", req.Consensus, req.Consensus.String())
+	}
+	if err != nil {
+		return err
 	}
 
-	return fmt.Errorf("invalid consensus specified (%v %s)", req.Consensus, req.Consensus.String())
+	// Remember who requested the cluster, as only they may cancel the execution.
+	w.origins.Set(req.RequestID, from)
+
+	return nil
 }
 
 // processDisbandCluster will start cluster shutdown command.
@@ -102,6 +112,7 @@ func (w *Worker) leaveCluster(requestID string, timeout time.Duration) error {
 	}
 
 	w.clusters.Delete(requestID)
+	w.origins.Delete(requestID)
 
 	return nil
 }
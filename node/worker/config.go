@@ -2,9 +2,13 @@ package worker
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"path/filepath"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/Maelkum/b7s/metadata"
 )
@@ -14,15 +18,24 @@ type Option func(*Config)
 
 // DefaultConfig represents the default settings for the node.
 var DefaultConfig = Config{
-	LoadAttributes:   DefaultAttributeLoadingSetting,
-	MetadataProvider: metadata.NewNoopProvider(),
+	LoadAttributes:            DefaultAttributeLoadingSetting,
+	AttributeGateways:         DefaultAttributeGateways,
+	AttributesRefreshInterval: DefaultAttributesRefreshInterval,
+	MetadataProvider:          metadata.NewNoopProvider(),
 }
 
+// DefaultAttributeGateways lists the IPFS gateways used to retrieve node attributes.
+var DefaultAttributeGateways = []string{"https://cf-ipfs.com"}
+
 // Config represents the Node configuration.
 type Config struct {
-	Workspace        string            // Directory where we can store files needed for execution.
-	LoadAttributes   bool              // Node should try to load its attributes from IPFS.
-	MetadataProvider metadata.Provider // Metadata provider for the node
+	Workspace                 string            // Directory where we can store files needed for execution.
+	LoadAttributes            bool              // Node should try to load its attributes.
+	AttributesPath            string            // Local file with the node attestation. If set, IPFS gateways are not used.
+	AttributeGateways         []string          // IPFS gateways used to retrieve the node attestation.
+	AttributesRefreshInterval time.Duration     // How often should the node reload its attributes. Zero disables reloading.
+	MetadataProvider          metadata.Provider // Metadata provider for the node
+	TrustedHeads              []peer.ID         // Head nodes allowed to uninstall functions from the node.
 }
 
 // Validate checks if the given configuration is correct.
@@ -34,6 +47,21 @@ func (c Config) Valid() error {
 		err = multierror.Append(err, errors.New("workspace must be an absolute path"))
 	}
 
+	if c.LoadAttributes && c.AttributesPath == "" && len(c.AttributeGateways) == 0 {
+		err = multierror.Append(err, errors.New("attributes file or at least one gateway required for attribute loading"))
+	}
+
+	for _, gateway := range c.AttributeGateways {
+		u, perr := url.Parse(gateway)
+		if perr != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			err = multierror.Append(err, fmt.Errorf("invalid attribute gateway URL: %v", gateway))
+		}
+	}
+
+	if c.AttributesRefreshInterval < 0 {
+		err = multierror.Append(err, errors.New("attributes refresh interval cannot be negative"))
+	}
+
 	return err.ErrorOrNil()
 }
 
@@ -44,13 +72,41 @@ func Workspace(path string) Option {
 	}
 }
 
-// AttributeLoading specifies whether node should try to load its attributes data from IPFS.
+// AttributeLoading specifies whether node should try to load its attributes data.
 func AttributeLoading(b bool) Option {
 	return func(cfg *Config) {
 		cfg.LoadAttributes = b
 	}
 }
 
+// AttributesPath specifies the local file from which the node attestation is loaded.
+func AttributesPath(path string) Option {
+	return func(cfg *Config) {
+		cfg.AttributesPath = path
+	}
+}
+
+// AttributeGateways specifies the IPFS gateways used to retrieve the node attestation.
+func AttributeGateways(gateways []string) Option {
+	return func(cfg *Config) {
+		cfg.AttributeGateways = gateways
+	}
+}
+
+// AttributesRefreshInterval specifies how often the node should reload its attributes.
+func AttributesRefreshInterval(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.AttributesRefreshInterval = d
+	}
+}
+
+// TrustedHeads sets the head nodes allowed to uninstall functions from the node.
+func TrustedHeads(heads ...peer.ID) Option {
+	return func(cfg *Config) {
+		cfg.TrustedHeads = heads
+	}
+}
+
 // MetadataProvider sets the metadata provider for the node.
 func MetadataProvider(p metadata.Provider) Option {
 	return func(cfg *Config) {
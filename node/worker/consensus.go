@@ -10,6 +10,7 @@ import (
 	"github.com/Maelkum/b7s/consensus"
 	"github.com/Maelkum/b7s/consensus/pbft"
 	"github.com/Maelkum/b7s/consensus/raft"
+	"github.com/Maelkum/b7s/metadata"
 	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/models/request"
@@ -33,12 +34,22 @@ func (w *Worker) createRaftCluster(ctx context.Context, from peer.ID, fc request
 		ctx, cancel := context.WithTimeout(context.Background(), consensusClusterSendTimeout)
 		defer cancel()
 
-		metadata, err := w.cfg.MetadataProvider.Metadata(req.Execute, res.Result.Result)
+		metadata, err := metadata.Get(w.cfg.MetadataProvider, req.Execute, res.Result.Result)
 		if err != nil {
 			w.Log().Warn().Err(err).Msg("could not get metadata")
 		}
 		res.Metadata = metadata
 
+		if res.Code == codes.OK {
+			res.Cacheable = w.cacheable(ctx, req.Execute.FunctionID)
+		}
+
+		err = res.Sign(w.Host().PrivateKey(), fc.RequestID)
+		if err != nil {
+			w.Log().Error().Err(err).Msg("could not sign execution result")
+			return
+		}
+
 		msg := response.WorkOrder{
 			Code:      res.Code,
 			RequestID: fc.RequestID,
@@ -101,6 +112,13 @@ func (w *Worker) createPBFTCluster(ctx context.Context, from peer.ID, fc request
 		pbft.WithPostProcessors(cacheFn),
 		pbft.WithTraceInfo(ti),
 		pbft.WithMetadataProvider(w.cfg.MetadataProvider),
+		pbft.WithCacheable(func(functionID string) bool {
+			// Executions happen after we're done processing this message, so we cannot use its context.
+			ctx, cancel := context.WithTimeout(context.Background(), consensusClusterSendTimeout)
+			defer cancel()
+
+			return w.cacheable(ctx, functionID)
+		}),
 	)
 	if err != nil {
 		return fmt.Errorf("could not create PBFT node: %w", err)
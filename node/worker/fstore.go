@@ -2,6 +2,8 @@ package worker
 
 import (
 	"context"
+
+	"github.com/Maelkum/b7s/models/bls"
 )
 
 // FStore provides retrieval of function manifest.
@@ -12,6 +14,15 @@ type FStore interface {
 	// IsInstalled returns info if the function is installed or not.
 	IsInstalled(cid string) (bool, error)
 
+	// Get retrieves the function record for the installed function.
+	Get(ctx context.Context, cid string) (bls.FunctionRecord, error)
+
+	// Remove deletes the function archive, the unpacked files and the function record.
+	Remove(ctx context.Context, cid string) error
+
+	// List retrieves the records of all installed functions.
+	List(ctx context.Context) ([]bls.FunctionRecord, error)
+
 	// TODO: Refactor the sync code - move the logic outside of the package
 	// Sync will ensure function installations are correct, redownloading functions if needed.
 	Sync(ctx context.Context, haltOnError bool) error
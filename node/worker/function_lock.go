@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"sync"
+)
+
+// functionLocks synchronizes work on installed functions. Executions share the lock of the function they run,
+// while installing or removing a function requires exclusive access to it.
+type functionLocks struct {
+	sync.Mutex
+	locks map[string]*functionLock
+}
+
+type functionLock struct {
+	sync.RWMutex
+	refs int // Number of goroutines holding or waiting for the lock.
+}
+
+func newFunctionLocks() *functionLocks {
+
+	l := functionLocks{
+		locks: make(map[string]*functionLock),
+	}
+
+	return &l
+}
+
+// rlock acquires shared access to the function. It returns the function that releases the lock.
+func (l *functionLocks) rlock(cid string) func() {
+
+	lock := l.acquire(cid)
+	lock.RLock()
+
+	return func() {
+		lock.RUnlock()
+		l.release(cid)
+	}
+}
+
+// lock acquires exclusive access to the function. It returns the function that releases the lock.
+func (l *functionLocks) lock(cid string) func() {
+
+	lock := l.acquire(cid)
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+		l.release(cid)
+	}
+}
+
+// tryLock acquires exclusive access to the function, unless the function is already in use.
+// On success, it returns the function that releases the lock.
+func (l *functionLocks) tryLock(cid string) (func(), bool) {
+
+	lock := l.acquire(cid)
+	if !lock.TryLock() {
+		l.release(cid)
+		return nil, false
+	}
+
+	return func() {
+		lock.Unlock()
+		l.release(cid)
+	}, true
+}
+
+func (l *functionLocks) acquire(cid string) *functionLock {
+
+	l.Lock()
+	defer l.Unlock()
+
+	lock, ok := l.locks[cid]
+	if !ok {
+		lock = &functionLock{}
+		l.locks[cid] = lock
+	}
+
+	lock.refs++
+
+	return lock
+}
+
+// release drops the reference to the function lock, removing it once no one is using it.
+func (l *functionLocks) release(cid string) {
+
+	l.Lock()
+	defer l.Unlock()
+
+	lock := l.locks[cid]
+	lock.refs--
+	if lock.refs == 0 {
+		delete(l.locks, cid)
+	}
+}
@@ -15,11 +15,18 @@ func (w *Worker) processInstallFunction(ctx context.Context, from peer.ID, req r
 	// Install function.
 	err := w.installFunction(ctx, req.CID, req.ManifestURL)
 	if err != nil {
+
+		// Let the caller know the installation failed.
+		sendErr := w.Send(ctx, from, req.Response(codes.Error, err.Error()))
+		if sendErr != nil {
+			w.Log().Warn().Err(sendErr).Stringer("peer", from).Str("cid", req.CID).Msg("could not send install failure response")
+		}
+
 		return fmt.Errorf("could not install function: %w", err)
 	}
 
 	// Reply to the caller.
-	err = w.Send(ctx, from, req.Response(codes.Accepted))
+	err = w.Send(ctx, from, req.Response(codes.Accepted, "installed"))
 	if err != nil {
 		return fmt.Errorf("could not send the response (peer: %s): %w", from, err)
 	}
@@ -30,6 +37,10 @@ func (w *Worker) processInstallFunction(ctx context.Context, from peer.ID, req r
 // installFunction will check if the function is installed first, and install it if not.
 func (w *Worker) installFunction(ctx context.Context, cid string, manifestURL string) error {
 
+	// Do not install the function while it's being removed.
+	unlock := w.functions.lock(cid)
+	defer unlock()
+
 	// Check if the function is installed.
 	installed, err := w.fstore.IsInstalled(cid)
 	if err != nil {
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessInstallFunction(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+		cid       = "dummy-cid"
+	)
+
+	setupWorker := func(t *testing.T, fstore *mocks.FStore, responses chan<- response.InstallFunction) *Worker {
+		t.Helper()
+
+		core := mocks.BaselineNodeCore(t)
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+			res, ok := any(msg).(*response.InstallFunction)
+			require.True(t, ok)
+
+			responses <- *res
+			return nil
+		}
+
+		worker, err := New(core, fstore, mocks.BaselineExecutor(t), Workspace(t.TempDir()))
+		require.NoError(t, err)
+
+		return worker
+	}
+
+	t.Run("function is installed", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.IsInstalledFunc = func(string) (bool, error) {
+			return false, nil
+		}
+
+		responses := make(chan response.InstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		err := worker.processInstallFunction(context.Background(), mocks.GenericPeerID, request.InstallFunction{RequestID: requestID, CID: cid})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, requestID, res.RequestID)
+		require.Equal(t, cid, res.CID)
+		require.Equal(t, codes.Accepted, res.Code)
+	})
+	t.Run("failure to install function is reported", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.IsInstalledFunc = func(string) (bool, error) {
+			return false, nil
+		}
+		fstore.InstallFunc = func(context.Context, string, string) error {
+			return mocks.GenericError
+		}
+
+		responses := make(chan response.InstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		err := worker.processInstallFunction(context.Background(), mocks.GenericPeerID, request.InstallFunction{RequestID: requestID, CID: cid})
+		require.Error(t, err)
+
+		res := <-responses
+		require.Equal(t, requestID, res.RequestID)
+		require.Equal(t, codes.Error, res.Code)
+		require.Contains(t, res.Message, mocks.GenericError.Error())
+	})
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+)
+
+// processFunctionInventory reports the functions installed on this node.
+func (w *Worker) processFunctionInventory(ctx context.Context, from peer.ID, req request.FunctionInventory) error {
+
+	log := w.Log().With().Stringer("peer", from).Str("request", req.RequestID).Logger()
+
+	log.Debug().Msg("received function inventory request")
+
+	records, err := w.fstore.List(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("could not retrieve installed functions")
+
+		err = w.Send(ctx, from, req.Response(codes.Error, nil))
+		if err != nil {
+			return fmt.Errorf("could not send response: %w", err)
+		}
+		return nil
+	}
+
+	functions := make([]bls.InstalledFunction, 0, len(records))
+	for _, record := range records {
+		functions = append(functions, record.Installed())
+	}
+
+	err = w.Send(ctx, from, req.Response(codes.OK, functions))
+	if err != nil {
+		return fmt.Errorf("could not send response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessFunctionInventory(t *testing.T) {
+
+	const (
+		requestID = "request-id"
+	)
+
+	setupWorker := func(t *testing.T, fstore *mocks.FStore, responses chan<- response.FunctionInventory) *Worker {
+		t.Helper()
+
+		core := mocks.BaselineNodeCore(t)
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+			res, ok := any(msg).(*response.FunctionInventory)
+			require.True(t, ok)
+
+			responses <- *res
+			return nil
+		}
+
+		worker, err := New(core, fstore, mocks.BaselineExecutor(t), Workspace(t.TempDir()))
+		require.NoError(t, err)
+
+		return worker
+	}
+
+	t.Run("installed functions are reported", func(t *testing.T) {
+		t.Parallel()
+
+		responses := make(chan response.FunctionInventory, 1)
+		worker := setupWorker(t, mocks.BaselineFStore(t), responses)
+
+		err := worker.processFunctionInventory(context.Background(), mocks.GenericPeerID, request.FunctionInventory{RequestID: requestID})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, requestID, res.RequestID)
+		require.Equal(t, codes.OK, res.Code)
+		require.Equal(t, []bls.InstalledFunction{mocks.GenericFunctionRecord.Installed()}, res.Functions)
+	})
+	t.Run("failure to list functions is reported", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.ListFunc = func(context.Context) ([]bls.FunctionRecord, error) {
+			return nil, errors.New("list failed")
+		}
+
+		responses := make(chan response.FunctionInventory, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		err := worker.processFunctionInventory(context.Background(), mocks.GenericPeerID, request.FunctionInventory{RequestID: requestID})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, requestID, res.RequestID)
+		require.Equal(t, codes.Error, res.Code)
+		require.Empty(t, res.Functions)
+	})
+}
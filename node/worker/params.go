@@ -5,7 +5,8 @@ import (
 )
 
 const (
-	DefaultAttributeLoadingSetting = false
+	DefaultAttributeLoadingSetting   = false
+	DefaultAttributesRefreshInterval = time.Hour
 
 	ClusterAddressTTL = 30 * time.Minute
 
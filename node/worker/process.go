@@ -17,6 +17,8 @@ func (w *Worker) process(ctx context.Context, from peer.ID, msg string, payload
 		return node.HandleMessage(ctx, from, payload, w.processHealthCheck)
 	case bls.MessageInstallFunction:
 		return node.HandleMessage(ctx, from, payload, w.processInstallFunction)
+	case bls.MessageUninstallFunction:
+		return node.HandleMessage(ctx, from, payload, w.processUninstallFunction)
 	case bls.MessageRollCall:
 		return node.HandleMessage(ctx, from, payload, w.processRollCall)
 	case bls.MessageWorkOrder:
@@ -25,6 +27,10 @@ func (w *Worker) process(ctx context.Context, from peer.ID, msg string, payload
 		return node.HandleMessage(ctx, from, payload, w.processFormCluster)
 	case bls.MessageDisbandCluster:
 		return node.HandleMessage(ctx, from, payload, w.processDisbandCluster)
+	case bls.MessageCancelExecution:
+		return node.HandleMessage(ctx, from, payload, w.processCancelExecution)
+	case bls.MessageFunctionInventory:
+		return node.HandleMessage(ctx, from, payload, w.processFunctionInventory)
 	}
 
 	return fmt.Errorf("unsupported message: %s", msg)
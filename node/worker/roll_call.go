@@ -31,12 +31,19 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 
 	if req.Attributes != nil {
 
-		if w.attributes == nil {
+		attestation := w.attributes.Load()
+		if attestation == nil {
 			log.Info().Msg("skipping attributed roll call - no attributes set")
 			return nil
 		}
 
-		err := haveAttributes(*w.attributes, *req.Attributes)
+		err := verifyAttestationSubject(*attestation, w.Host().ID())
+		if err != nil {
+			log.Warn().Err(err).Msg("skipping attributed roll call - attestation is not about this node")
+			return nil
+		}
+
+		err = haveAttributes(*attestation, *req.Attributes)
 		if err != nil {
 			log.Info().Err(err).Msg("skipping attributed roll call - we do not match requested attributes")
 			return nil
@@ -72,12 +79,14 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 		}
 	}
 
-	log.Info().Msg("reporting for roll call")
+	capacity := w.capacity(installed)
+
+	log.Info().Any("capacity", capacity).Msg("reporting for roll call")
 
 	w.Metrics().IncrCounterWithLabels(rollCallsAppliedMetric, 1, []metrics.Label{{Name: "function", Value: req.FunctionID}})
 
 	// Send positive response.
-	err = w.Send(ctx, from, req.Response(codes.Accepted))
+	err = w.Send(ctx, from, req.Response(codes.Accepted).WithCapacity(capacity))
 	if err != nil {
 		return fmt.Errorf("could not send response: %w", err)
 	}
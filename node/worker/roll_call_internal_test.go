@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessRollCall_Capacity(t *testing.T) {
+
+	var (
+		req = request.RollCall{
+			FunctionID: "function-id",
+			RequestID:  "request-id",
+		}
+
+		load = bls.Load{
+			Active: 3,
+			Limit:  10,
+			Queued: 2,
+		}
+	)
+
+	var res *response.RollCall
+	core := mocks.BaselineNodeCore(t)
+	core.LoadFunc = func() bls.Load {
+		return load
+	}
+	core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+		rc, ok := any(msg).(*response.RollCall)
+		require.True(t, ok)
+		res = rc
+		return nil
+	}
+
+	worker := createWorkerNode(t)
+	worker.Core = core
+	worker.executions.Set("running-request-id", runningExecution{functionID: mocks.GenericFunctionRecord.CID, cancel: func() {}})
+
+	err := worker.processRollCall(context.Background(), mocks.GenericPeerID, req)
+	require.NoError(t, err)
+
+	require.NotNil(t, res)
+	require.Equal(t, codes.Accepted, res.Code)
+	require.NotNil(t, res.Capacity)
+
+	require.Equal(t, load.Limit, res.Capacity.Slots)
+	require.Equal(t, load.Limit-load.Active, res.Capacity.FreeSlots)
+	require.Equal(t, load.Queued, res.Capacity.Queued)
+	require.Equal(t, uint(1), res.Capacity.Executions)
+	require.Equal(t, uint(0), res.Capacity.Clusters)
+	require.True(t, res.Capacity.Cached)
+}
@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/request"
+)
+
+func (w *Worker) processUninstallFunction(ctx context.Context, from peer.ID, req request.UninstallFunction) error {
+
+	log := w.Log().With().Stringer("peer", from).Str("cid", req.CID).Logger()
+
+	log.Info().Msg("received request to uninstall function")
+
+	// Only trusted head nodes may remove functions.
+	if !slices.Contains(w.cfg.TrustedHeads, from) {
+		log.Warn().Msg("uninstall requested by an untrusted peer")
+		return w.Send(ctx, from, req.Response(codes.NotPermitted, "peer not permitted to uninstall functions"))
+	}
+
+	code, message := w.uninstallFunction(ctx, req.CID)
+	if code != codes.OK {
+		log.Warn().Stringer("code", code).Str("reason", message).Msg("function not uninstalled")
+	}
+
+	// Reply to the caller.
+	err := w.Send(ctx, from, req.Response(code, message))
+	if err != nil {
+		return fmt.Errorf("could not send the response (peer: %s): %w", from, err)
+	}
+
+	return nil
+}
+
+// uninstallFunction removes the function, unless it is currently being executed or installed.
+func (w *Worker) uninstallFunction(ctx context.Context, cid string) (codes.Code, string) {
+
+	// Do not pull the function from under a running execution. Executions starting in the meantime wait for the removal to complete.
+	unlock, ok := w.functions.tryLock(cid)
+	if !ok {
+		return codes.NotAvailable, "function is in use"
+	}
+	defer unlock()
+
+	err := w.fstore.Remove(ctx, cid)
+	if errors.Is(err, bls.ErrNotFound) {
+		return codes.NotFound, "function not installed"
+	}
+	if err != nil {
+		w.Log().Error().Err(err).Str("cid", cid).Msg("could not remove function")
+		return codes.Error, "could not remove function"
+	}
+
+	return codes.OK, "uninstalled"
+}
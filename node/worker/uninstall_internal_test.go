@@ -0,0 +1,179 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Maelkum/b7s/models/bls"
+	"github.com/Maelkum/b7s/models/codes"
+	"github.com/Maelkum/b7s/models/execute"
+	"github.com/Maelkum/b7s/models/request"
+	"github.com/Maelkum/b7s/models/response"
+	"github.com/Maelkum/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessUninstallFunction(t *testing.T) {
+
+	const (
+		cid = "dummy-cid"
+	)
+
+	setupWorker := func(t *testing.T, fstore *mocks.FStore, responses chan<- response.UninstallFunction) *Worker {
+		t.Helper()
+
+		core := mocks.BaselineNodeCore(t)
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+			res, ok := any(msg).(*response.UninstallFunction)
+			require.True(t, ok)
+
+			responses <- *res
+			return nil
+		}
+
+		worker, err := New(core, fstore, mocks.BaselineExecutor(t), Workspace(t.TempDir()), TrustedHeads(mocks.GenericPeerID))
+		require.NoError(t, err)
+
+		return worker
+	}
+
+	t.Run("function is removed", func(t *testing.T) {
+		t.Parallel()
+
+		var removed string
+		fstore := mocks.BaselineFStore(t)
+		fstore.RemoveFunc = func(_ context.Context, id string) error {
+			removed = id
+			return nil
+		}
+
+		responses := make(chan response.UninstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, request.UninstallFunction{RequestID: mocks.GenericUUID.String(), CID: cid})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, codes.OK, res.Code)
+		require.Equal(t, mocks.GenericUUID.String(), res.RequestID)
+		require.Equal(t, cid, res.CID)
+		require.Equal(t, cid, removed)
+	})
+	t.Run("function being executed is not removed", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.RemoveFunc = func(context.Context, string) error {
+			require.FailNow(t, "function should not be removed")
+			return nil
+		}
+
+		responses := make(chan response.UninstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		started := make(chan struct{})
+		done := make(chan struct{})
+		executor := mocks.BaselineExecutor(t)
+		executor.ExecFunctionFunc = func(context.Context, string, execute.Request) (execute.Result, error) {
+			close(started)
+			<-done
+			return mocks.GenericExecutionResult, nil
+		}
+		worker.executor = &cancellableExecutor{Executor: executor, running: worker.executions, functions: worker.functions}
+
+		req := mocks.GenericExecutionRequest
+		req.FunctionID = cid
+
+		executed := make(chan error)
+		go func() {
+			_, err := worker.executor.ExecuteFunction(context.Background(), "running-request-id", req)
+			executed <- err
+		}()
+		<-started
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, request.UninstallFunction{CID: cid})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, codes.NotAvailable, res.Code)
+
+		close(done)
+		require.NoError(t, <-executed)
+	})
+	t.Run("function is not removed during installation", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.RemoveFunc = func(context.Context, string) error {
+			require.FailNow(t, "function should not be removed")
+			return nil
+		}
+
+		responses := make(chan response.UninstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		unlock := worker.functions.lock(cid)
+		defer unlock()
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, request.UninstallFunction{CID: cid})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, codes.NotAvailable, res.Code)
+	})
+	t.Run("untrusted peer cannot remove function", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.RemoveFunc = func(context.Context, string) error {
+			require.FailNow(t, "function should not be removed")
+			return nil
+		}
+
+		responses := make(chan response.UninstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerIDs[0], request.UninstallFunction{CID: cid})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, codes.NotPermitted, res.Code)
+	})
+	t.Run("function not installed", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.RemoveFunc = func(context.Context, string) error {
+			return fmt.Errorf("could not retrieve function record: %w", bls.ErrNotFound)
+		}
+
+		responses := make(chan response.UninstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, request.UninstallFunction{CID: cid})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, codes.NotFound, res.Code)
+	})
+	t.Run("failure to remove function is reported", func(t *testing.T) {
+		t.Parallel()
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.RemoveFunc = func(context.Context, string) error {
+			return mocks.GenericError
+		}
+
+		responses := make(chan response.UninstallFunction, 1)
+		worker := setupWorker(t, fstore, responses)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, request.UninstallFunction{CID: cid})
+		require.NoError(t, err)
+
+		res := <-responses
+		require.Equal(t, codes.Error, res.Code)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/Maelkum/b7s/consensus"
+	"github.com/Maelkum/b7s/metadata"
 	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/execute"
 	"github.com/Maelkum/b7s/models/request"
@@ -38,7 +39,7 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 		log.Error().Err(err).Stringer("peer", from).Msg("execution failed")
 	}
 
-	metadata, err := w.cfg.MetadataProvider.Metadata(req.Request, result.Result)
+	metadata, err := metadata.Get(w.cfg.MetadataProvider, req.Request, result.Result)
 	if err != nil {
 		log.Error().Err(err).Msg("could not get metadata for the execution result")
 	}
@@ -56,6 +57,15 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 
 	// Prepare a work order response.
 	res := req.Response(code, result).WithMetadata(metadata)
+	if code == codes.OK {
+		res.Result.Cacheable = w.cacheable(ctx, req.FunctionID)
+	}
+
+	// Sign the result so the head node can verify it was not altered.
+	err = res.Result.Sign(w.Host().PrivateKey(), requestID)
+	if err != nil {
+		return fmt.Errorf("could not sign execution result: %w", err)
+	}
 
 	log.Info().Stringer("code", code).Msg("execution complete")
 
@@ -68,6 +78,18 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 	return nil
 }
 
+// cacheable reports whether the function is marked as cacheable in its manifest, meaning the head node can reuse its results for identical requests.
+func (w *Worker) cacheable(ctx context.Context, functionID string) bool {
+
+	fn, err := w.fstore.Get(ctx, functionID)
+	if err != nil {
+		w.Log().Warn().Err(err).Str("function", functionID).Msg("could not retrieve function record")
+		return false
+	}
+
+	return fn.Manifest.Cached
+}
+
 func (w *Worker) execute(ctx context.Context, requestID string, timestamp time.Time, req execute.Request, from peer.ID) (codes.Code, execute.Result, error) {
 
 	// Check if we have function in store.
@@ -93,6 +115,9 @@ func (w *Worker) execute(ctx context.Context, requestID string, timestamp time.T
 	// We are not part of a cluster - just execute the request.
 	if !consensusRequired(cs) {
 
+		w.origins.Set(requestID, from)
+		defer w.origins.Delete(requestID)
+
 		res, err := w.executor.ExecuteFunction(ctx, requestID, req)
 		if err != nil {
 			return res.Code, res, fmt.Errorf("execution failed: %w", err)
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand/v2"
@@ -83,6 +84,10 @@ func TestWorker_ProcessWorkOrder(t *testing.T) {
 		require.Equal(t, result.Result, er.Result.Result.Result) // RuntimeOutput
 		require.Equal(t, result.Usage, er.Result.Result.Usage)
 
+		// Verify result is signed by the worker.
+		err := er.Result.VerifySignature(core.Host().PublicKey(), er.RequestID)
+		require.NoError(t, err)
+
 		return nil
 	}
 
@@ -129,7 +134,9 @@ func TestWorker_ProcessWorkOrder_Metadata(t *testing.T) {
 	core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
 		er, ok := any(msg).(*response.WorkOrder)
 		require.True(t, ok)
-		require.Equal(t, data, er.Result.Metadata)
+		expected, err := json.Marshal(data)
+		require.NoError(t, err)
+		require.JSONEq(t, string(expected), string(er.Result.Metadata))
 
 		return nil
 	}
@@ -140,6 +147,50 @@ func TestWorker_ProcessWorkOrder_Metadata(t *testing.T) {
 
 }
 
+func TestWorker_ProcessWorkOrder_Cacheable(t *testing.T) {
+
+	var (
+		req = request.WorkOrder{
+			RequestID: "request-id",
+			Request:   mocks.GenericExecutionRequest,
+		}
+	)
+
+	worker := createWorkerNode(t)
+
+	executor := mocks.BaselineExecutor(t)
+	executor.ExecFunctionFunc = func(context.Context, string, execute.Request) (execute.Result, error) {
+		return execute.Result{Code: codes.OK}, nil
+	}
+	worker.executor = executor
+
+	// Function is marked as cacheable in its manifest.
+	fstore := mocks.BaselineFStore(t)
+	fstore.GetFunc = func(context.Context, string) (bls.FunctionRecord, error) {
+		fn := mocks.GenericFunctionRecord
+		fn.Manifest.Cached = true
+		return fn, nil
+	}
+	worker.fstore = fstore
+
+	core := mocks.BaselineNodeCore(t)
+	core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+		er, ok := any(msg).(*response.WorkOrder)
+		require.True(t, ok)
+		require.True(t, er.Result.Cacheable)
+
+		// Cacheable flag is covered by the signature.
+		err := er.Result.VerifySignature(core.Host().PublicKey(), er.RequestID)
+		require.NoError(t, err)
+
+		return nil
+	}
+	worker.Core = core
+
+	err := worker.processWorkOrder(context.Background(), mocks.GenericPeerID, req)
+	require.NoError(t, err)
+}
+
 func TestWorker_ProcessWorkOrder_HandlesErrors(t *testing.T) {
 
 	t.Run("function lookup error", func(t *testing.T) {
@@ -3,8 +3,10 @@ package worker
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/Maelkum/b7s/info"
 	"github.com/Maelkum/b7s/models/bls"
@@ -23,10 +25,14 @@ type Worker struct {
 	executor bls.Executor
 	fstore   FStore
 
-	attributes *attributes.Attestation
+	attributes atomic.Pointer[attributes.Attestation]
 
 	clusters         *syncmap.Map[string, consensusExecutor] // clusters maps request ID to the cluster the node belongs to.
 	executeResponses *waitmap.WaitMap[string, execute.NodeResult]
+
+	executions *syncmap.Map[string, runningExecution] // executions maps request ID to the running execution.
+	origins    *syncmap.Map[string, peer.ID]          // origins maps request ID to the node that requested the execution.
+	functions  *functionLocks                         // functions guards installed functions against removal while in use.
 }
 
 func New(core node.Core, fstore FStore, executor bls.Executor, options ...Option) (*Worker, error) {
@@ -42,19 +48,27 @@ func New(core node.Core, fstore FStore, executor bls.Executor, options ...Option
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	executions := syncmap.New[string, runningExecution]()
+	functions := newFunctionLocks()
+
 	worker := &Worker{
 		Core: core,
 		cfg:  cfg,
 
-		fstore:           fstore,
-		executor:         executor,
+		fstore: fstore,
+		// Wrap the executor so that executions - including those done as part of a cluster - can be cancelled.
+		executor:         &cancellableExecutor{Executor: executor, running: executions, functions: functions},
 		clusters:         syncmap.New[string, consensusExecutor](),
 		executeResponses: waitmap.New[string, execute.NodeResult](1000),
+
+		executions: executions,
+		origins:    syncmap.New[string, peer.ID](),
+		functions:  functions,
 	}
 
 	if cfg.LoadAttributes {
 
-		attributes, err := loadAttributes(core.Host().PublicKey())
+		attributes, err := worker.loadAttributes(context.Background())
 		if err != nil {
 			return nil, fmt.Errorf("could not load attribute data: %w", err)
 		}
@@ -63,7 +77,7 @@ func New(core node.Core, fstore FStore, executor bls.Executor, options ...Option
 			Any("attributes", attributes).
 			Msg("node loaded attributes")
 
-		worker.attributes = &attributes
+		worker.attributes.Store(&attributes)
 	}
 
 	worker.Metrics().SetGaugeWithLabels(node.NodeInfoMetric, 1,
@@ -87,5 +101,10 @@ func (w *Worker) Run(ctx context.Context) error {
 	// Start the function sync in the background to periodically check functions.
 	go w.runSyncLoop(ctx)
 
+	// Periodically reload attributes in case the attestation changed.
+	if w.cfg.LoadAttributes && w.cfg.AttributesRefreshInterval > 0 {
+		go w.runAttributesRefreshLoop(ctx)
+	}
+
 	return w.Core.Run(ctx, w.process)
 }
@@ -1,8 +1,11 @@
 package store
 
 const (
-	PrefixPeer     = 1
-	PrefixFunction = 2
+	PrefixPeer       = 1
+	PrefixFunction   = 2
+	PrefixExecution  = 3
+	PrefixSchedule   = 4
+	PrefixReputation = 5
 )
 
 const (
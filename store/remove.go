@@ -35,6 +35,44 @@ func (s *Store) RemoveFunction(_ context.Context, cid string) error {
 	return nil
 }
 
+func (s *Store) RemoveExecution(_ context.Context, requestID string) error {
+
+	key := encodeKey(PrefixExecution, requestID)
+	err := s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove execution record: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemoveSchedule(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixSchedule, id)
+	err := s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemoveReputation(_ context.Context, id peer.ID) error {
+
+	idBytes, err := id.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not encode peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixReputation, idBytes)
+	err = s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove reputation: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Store) remove(key []byte) error {
 	return s.db.Delete(key, pebble.Sync)
 }
@@ -86,6 +86,122 @@ func (s *Store) RetrieveFunctions(_ context.Context) ([]bls.FunctionRecord, erro
 	return functions, nil
 }
 
+func (s *Store) RetrieveExecution(_ context.Context, requestID string) (bls.ExecutionRecord, error) {
+
+	key := encodeKey(PrefixExecution, requestID)
+	var record bls.ExecutionRecord
+	err := s.retrieve(key, &record)
+	if err != nil {
+		return bls.ExecutionRecord{}, fmt.Errorf("could not retrieve execution record: %w", err)
+	}
+
+	return record, nil
+}
+
+func (s *Store) RetrieveExecutions(_ context.Context) ([]bls.ExecutionRecord, error) {
+
+	records := make([]bls.ExecutionRecord, 0)
+
+	opts := prefixIterOptions([]byte{PrefixExecution})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+
+		var record bls.ExecutionRecord
+		err := s.retrieve(it.Key(), &record)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve execution record (key: %x): %w", it.Key(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (s *Store) RetrieveSchedule(_ context.Context, id string) (bls.Schedule, error) {
+
+	key := encodeKey(PrefixSchedule, id)
+	var schedule bls.Schedule
+	err := s.retrieve(key, &schedule)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *Store) RetrieveSchedules(_ context.Context) ([]bls.Schedule, error) {
+
+	schedules := make([]bls.Schedule, 0)
+
+	opts := prefixIterOptions([]byte{PrefixSchedule})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+
+		var schedule bls.Schedule
+		err := s.retrieve(it.Key(), &schedule)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve schedule (key: %x): %w", it.Key(), err)
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+func (s *Store) RetrieveReputation(_ context.Context, id peer.ID) (bls.Reputation, error) {
+
+	idBytes, err := id.MarshalBinary()
+	if err != nil {
+		return bls.Reputation{}, fmt.Errorf("could not serialize peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixReputation, idBytes)
+	var reputation bls.Reputation
+	err = s.retrieve(key, &reputation)
+	if err != nil {
+		return bls.Reputation{}, fmt.Errorf("could not retrieve reputation: %w", err)
+	}
+
+	return reputation, nil
+}
+
+func (s *Store) RetrieveReputations(_ context.Context) ([]bls.Reputation, error) {
+
+	reputations := make([]bls.Reputation, 0)
+
+	opts := prefixIterOptions([]byte{PrefixReputation})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+
+		var reputation bls.Reputation
+		err := s.retrieve(it.Key(), &reputation)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve reputation (key: %x): %w", it.Key(), err)
+		}
+
+		reputations = append(reputations, reputation)
+	}
+
+	return reputations, nil
+}
+
 func (s *Store) retrieve(key []byte, out any) error {
 
 	value, closer, err := s.db.Get(key)
@@ -36,6 +36,44 @@ func (s *Store) SaveFunction(_ context.Context, function bls.FunctionRecord) err
 	return nil
 }
 
+func (s *Store) SaveExecution(_ context.Context, record bls.ExecutionRecord) error {
+
+	key := encodeKey(PrefixExecution, record.RequestID)
+	err := s.save(key, record)
+	if err != nil {
+		return fmt.Errorf("could not save execution record: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SaveSchedule(_ context.Context, schedule bls.Schedule) error {
+
+	key := encodeKey(PrefixSchedule, schedule.ID)
+	err := s.save(key, schedule)
+	if err != nil {
+		return fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SaveReputation(_ context.Context, reputation bls.Reputation) error {
+
+	id, err := reputation.Peer.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not serialize peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixReputation, id)
+	err = s.save(key, reputation)
+	if err != nil {
+		return fmt.Errorf("could not save reputation: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Store) save(key []byte, value any) error {
 
 	encoded, err := s.codec.Marshal(value)
@@ -140,6 +140,134 @@ func TestStore_RetrieveFunctions(t *testing.T) {
 	}
 }
 
+func TestStore_ExecutionOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	record := mocks.GenericExecutionRecord
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save execution", func(t *testing.T) {
+		err := store.SaveExecution(ctx, record)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve execution", func(t *testing.T) {
+		retrieved, err := store.RetrieveExecution(ctx, record.RequestID)
+		require.NoError(t, err)
+
+		require.Equal(t, record, retrieved)
+	})
+	t.Run("remove execution", func(t *testing.T) {
+		err := store.RemoveExecution(ctx, record.RequestID)
+		require.NoError(t, err)
+
+		// Verify execution record is gone.
+		_, err = store.RetrieveExecution(ctx, record.RequestID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestStore_RetrieveExecutions(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	count := 10
+	records := make(map[string]bls.ExecutionRecord)
+	for i := 0; i < count; i++ {
+
+		record := mocks.GenericExecutionRecord
+		record.RequestID = fmt.Sprintf("dummy-request-id-%v", i)
+
+		records[record.RequestID] = record
+	}
+
+	// Save execution records.
+	for _, record := range records {
+		err := store.SaveExecution(ctx, record)
+		require.NoError(t, err)
+	}
+
+	retrieved, err := store.RetrieveExecutions(ctx)
+	require.NoError(t, err)
+	require.Len(t, retrieved, count)
+
+	// Verify execution records.
+	for _, record := range retrieved {
+		require.Equal(t, records[record.RequestID], record)
+	}
+}
+
+func TestStore_ScheduleOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	schedule := mocks.GenericSchedule
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save schedule", func(t *testing.T) {
+		err := store.SaveSchedule(ctx, schedule)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve schedule", func(t *testing.T) {
+		retrieved, err := store.RetrieveSchedule(ctx, schedule.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, schedule, retrieved)
+	})
+	t.Run("retrieve schedules", func(t *testing.T) {
+		retrieved, err := store.RetrieveSchedules(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []bls.Schedule{schedule}, retrieved)
+	})
+	t.Run("remove schedule", func(t *testing.T) {
+		err := store.RemoveSchedule(ctx, schedule.ID)
+		require.NoError(t, err)
+
+		// Verify schedule is gone.
+		_, err = store.RetrieveSchedule(ctx, schedule.ID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestStore_ReputationOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	reputation := mocks.GenericReputation
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save reputation", func(t *testing.T) {
+		err := store.SaveReputation(ctx, reputation)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve reputation", func(t *testing.T) {
+		retrieved, err := store.RetrieveReputation(ctx, reputation.Peer)
+		require.NoError(t, err)
+
+		require.Equal(t, reputation, retrieved)
+	})
+	t.Run("retrieve reputations", func(t *testing.T) {
+		retrieved, err := store.RetrieveReputations(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []bls.Reputation{reputation}, retrieved)
+	})
+	t.Run("remove reputation", func(t *testing.T) {
+		err := store.RemoveReputation(ctx, reputation.Peer)
+		require.NoError(t, err)
+
+		// Verify reputation is gone.
+		_, err = store.RetrieveReputation(ctx, reputation.Peer)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
 func TestStore_HandlesFailures(t *testing.T) {
 
 	db := helpers.InMemoryDB(t)
@@ -49,6 +49,16 @@ func (s *Store) SaveFunction(ctx context.Context, function bls.FunctionRecord) e
 	return s.tracer.WithSpanFromContext(ctx, "SaveFunction", callback, opts...)
 }
 
+func (s *Store) SaveExecution(ctx context.Context, record bls.ExecutionRecord) error {
+
+	callback := func() error {
+		return s.store.SaveExecution(ctx, record)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(record.RequestID)))
+	return s.tracer.WithSpanFromContext(ctx, "SaveExecution", callback, opts...)
+}
+
 func (s *Store) RetrievePeer(ctx context.Context, id peer.ID) (bls.Peer, error) {
 
 	var peer bls.Peer
@@ -103,6 +113,33 @@ func (s *Store) RetrieveFunctions(ctx context.Context) ([]bls.FunctionRecord, er
 	return functions, err
 }
 
+func (s *Store) RetrieveExecution(ctx context.Context, requestID string) (bls.ExecutionRecord, error) {
+
+	var record bls.ExecutionRecord
+	var err error
+	callback := func() error {
+		record, err = s.store.RetrieveExecution(ctx, requestID)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(requestID)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetExecution", callback, opts...)
+	return record, err
+}
+
+func (s *Store) RetrieveExecutions(ctx context.Context) ([]bls.ExecutionRecord, error) {
+
+	var records []bls.ExecutionRecord
+	var err error
+	callback := func() error {
+		records, err = s.store.RetrieveExecutions(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListExecutions", callback, storeSpanOptions()...)
+	return records, err
+}
+
 func (s *Store) RemovePeer(ctx context.Context, id peer.ID) error {
 
 	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(id.String())))
@@ -123,6 +160,110 @@ func (s *Store) RemoveFunction(ctx context.Context, cid string) error {
 		opts...)
 }
 
+func (s *Store) RemoveExecution(ctx context.Context, requestID string) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(requestID)))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveExecution",
+		func() error { return s.store.RemoveExecution(ctx, requestID) },
+		opts...)
+}
+
+func (s *Store) SaveSchedule(ctx context.Context, schedule bls.Schedule) error {
+
+	callback := func() error {
+		return s.store.SaveSchedule(ctx, schedule)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ScheduleID.String(schedule.ID)))
+	return s.tracer.WithSpanFromContext(ctx, "SaveSchedule", callback, opts...)
+}
+
+func (s *Store) RetrieveSchedule(ctx context.Context, id string) (bls.Schedule, error) {
+
+	var schedule bls.Schedule
+	var err error
+	callback := func() error {
+		schedule, err = s.store.RetrieveSchedule(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ScheduleID.String(id)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetSchedule", callback, opts...)
+	return schedule, err
+}
+
+func (s *Store) RetrieveSchedules(ctx context.Context) ([]bls.Schedule, error) {
+
+	var schedules []bls.Schedule
+	var err error
+	callback := func() error {
+		schedules, err = s.store.RetrieveSchedules(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListSchedules", callback, storeSpanOptions()...)
+	return schedules, err
+}
+
+func (s *Store) RemoveSchedule(ctx context.Context, id string) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ScheduleID.String(id)))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveSchedule",
+		func() error { return s.store.RemoveSchedule(ctx, id) },
+		opts...)
+}
+
+func (s *Store) SaveReputation(ctx context.Context, reputation bls.Reputation) error {
+
+	callback := func() error {
+		return s.store.SaveReputation(ctx, reputation)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(reputation.Peer.String())))
+	return s.tracer.WithSpanFromContext(ctx, "SaveReputation", callback, opts...)
+}
+
+func (s *Store) RetrieveReputation(ctx context.Context, id peer.ID) (bls.Reputation, error) {
+
+	var reputation bls.Reputation
+	var err error
+	callback := func() error {
+		reputation, err = s.store.RetrieveReputation(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(id.String())))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetReputation", callback, opts...)
+	return reputation, err
+}
+
+func (s *Store) RetrieveReputations(ctx context.Context) ([]bls.Reputation, error) {
+
+	var reputations []bls.Reputation
+	var err error
+	callback := func() error {
+		reputations, err = s.store.RetrieveReputations(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListReputations", callback, storeSpanOptions()...)
+	return reputations, err
+}
+
+func (s *Store) RemoveReputation(ctx context.Context, id peer.ID) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(id.String())))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveReputation",
+		func() error { return s.store.RemoveReputation(ctx, id) },
+		opts...)
+}
+
 func peerAttributes(peer bls.Peer) []attribute.KeyValue {
 	return []attribute.KeyValue{
 		b7ssemconv.PeerID.String(peer.ID.String()),
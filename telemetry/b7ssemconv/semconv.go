@@ -30,6 +30,10 @@ const (
 	ExecutionRequestID = attribute.Key("execution.request.id")
 )
 
+const (
+	ScheduleID = attribute.Key("schedule.id")
+)
+
 const (
 	PeerID         = attribute.Key("peer.id")
 	PeerMultiaddr  = attribute.Key("peer.multiaddr")
@@ -25,6 +25,7 @@ type NodeCore struct {
 	LogFunc            func() *zerolog.Logger
 	HostFunc           func() *host.Host
 	ConnectedFunc      func(peer.ID) bool
+	TopicPeersFunc     func(string) []peer.ID
 	SendFunc           func(context.Context, peer.ID, bls.Message) error
 	SendToManyFunc     func(context.Context, []peer.ID, bls.Message, bool) error
 	JoinTopicFunc      func(string) error
@@ -34,6 +35,7 @@ type NodeCore struct {
 	TracerFunc         func() *tracing.Tracer
 	MetricsFunc        func() *metrics.Metrics
 	RunFunc            func(context.Context, func(context.Context, peer.ID, string, []byte) error) error
+	LoadFunc           func() bls.Load
 }
 
 func BaselineNodeCore(t *testing.T) *NodeCore {
@@ -64,6 +66,9 @@ func BaselineNodeCore(t *testing.T) *NodeCore {
 		ConnectedFunc: func(peer.ID) bool {
 			return false
 		},
+		TopicPeersFunc: func(string) []peer.ID {
+			return nil
+		},
 		SendFunc: func(context.Context, peer.ID, bls.Message) error {
 			return nil
 		},
@@ -91,6 +96,9 @@ func BaselineNodeCore(t *testing.T) *NodeCore {
 		RunFunc: func(context.Context, func(context.Context, peer.ID, string, []byte) error) error {
 			return nil
 		},
+		LoadFunc: func() bls.Load {
+			return bls.Load{Limit: bls.DefaultConcurrency}
+		},
 	}
 
 	return &core
@@ -112,6 +120,10 @@ func (c NodeCore) Connected(peerID peer.ID) bool {
 	return c.ConnectedFunc(peerID)
 }
 
+func (c NodeCore) TopicPeers(topic string) []peer.ID {
+	return c.TopicPeersFunc(topic)
+}
+
 func (c NodeCore) Send(ctx context.Context, peerID peer.ID, msg bls.Message) error {
 	return c.SendFunc(ctx, peerID, msg)
 }
@@ -147,3 +159,7 @@ func (c NodeCore) Metrics() *metrics.Metrics {
 func (c NodeCore) Run(context.Context, func(context.Context, peer.ID, string, []byte) error) error {
 	return nil
 }
+
+func (c NodeCore) Load() bls.Load {
+	return c.LoadFunc()
+}
@@ -3,11 +3,16 @@ package mocks
 import (
 	"context"
 	"testing"
+
+	"github.com/Maelkum/b7s/models/bls"
 )
 
 type FStore struct {
 	InstallFunc     func(context.Context, string, string) error
 	IsInstalledFunc func(string) (bool, error)
+	GetFunc         func(context.Context, string) (bls.FunctionRecord, error)
+	ListFunc        func(context.Context) ([]bls.FunctionRecord, error)
+	RemoveFunc      func(context.Context, string) error
 	SyncFunc        func(context.Context, bool) error
 }
 
@@ -21,6 +26,15 @@ func BaselineFStore(t *testing.T) *FStore {
 		IsInstalledFunc: func(string) (bool, error) {
 			return true, nil
 		},
+		GetFunc: func(context.Context, string) (bls.FunctionRecord, error) {
+			return GenericFunctionRecord, nil
+		},
+		ListFunc: func(context.Context) ([]bls.FunctionRecord, error) {
+			return []bls.FunctionRecord{GenericFunctionRecord}, nil
+		},
+		RemoveFunc: func(context.Context, string) error {
+			return nil
+		},
 		SyncFunc: func(context.Context, bool) error {
 			return nil
 		},
@@ -37,6 +51,18 @@ func (f *FStore) IsInstalled(cid string) (bool, error) {
 	return f.IsInstalledFunc(cid)
 }
 
+func (f *FStore) Get(ctx context.Context, cid string) (bls.FunctionRecord, error) {
+	return f.GetFunc(ctx, cid)
+}
+
+func (f *FStore) List(ctx context.Context) ([]bls.FunctionRecord, error) {
+	return f.ListFunc(ctx)
+}
+
+func (f *FStore) Remove(ctx context.Context, cid string) error {
+	return f.RemoveFunc(ctx, cid)
+}
+
 func (f *FStore) Sync(ctx context.Context, haltOnError bool) error {
 	return f.SyncFunc(ctx, haltOnError)
 }
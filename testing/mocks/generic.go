@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -110,4 +111,70 @@ var (
 		Archive:  "/var/tmp/archive.tar.gz",
 		Files:    "/var/tmp/files",
 	}
+
+	GenericExecutionRecord = bls.ExecutionRecord{
+		RequestID: GenericUUID.String(),
+		Request:   GenericExecutionRequest,
+		Status:    execute.StatusDone,
+		Code:      codes.OK,
+		Results:   GenericExecutionResultMap,
+		Cluster: execute.Cluster{
+			Peers: []peer.ID{GenericPeerID},
+		},
+		StartedAt:   time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+		CompletedAt: time.Date(2024, time.March, 1, 12, 0, 5, 0, time.UTC),
+	}
+
+	GenericSchedule = bls.Schedule{
+		ID:        GenericUUID.String(),
+		Cron:      "@daily",
+		Request:   GenericExecutionRequest,
+		CreatedAt: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+		History: []bls.ScheduleRun{
+			{
+				RequestID:   GenericUUID.String(),
+				Code:        codes.OK,
+				StartedAt:   time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+				CompletedAt: time.Date(2024, time.March, 2, 0, 0, 5, 0, time.UTC),
+			},
+		},
+	}
+
+	GenericReputation = bls.Reputation{
+		Peer:      GenericPeerID,
+		Successes: 10,
+		Failures:  1,
+		NoResults: 1,
+		Latency:   200 * time.Millisecond,
+		UpdatedAt: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericFunctionInstall = bls.FunctionInstall{
+		RequestID: GenericUUID.String(),
+		CID:       GenericFunctionRecord.CID,
+		Done:      true,
+		Succeeded: []peer.ID{GenericPeerIDs[0]},
+		Failed: []bls.FunctionInstallFailure{
+			{
+				Peer:  GenericPeerIDs[1],
+				Error: "could not download function",
+			},
+		},
+		NoAnswer:    []peer.ID{GenericPeerIDs[2]},
+		CompletedAt: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericFunctionUninstall = bls.FunctionUninstall{
+		RequestID: GenericUUID.String(),
+		CID:       GenericFunctionRecord.CID,
+		Succeeded: []peer.ID{GenericPeerIDs[0]},
+		Failed: []bls.FunctionInstallFailure{
+			{
+				Peer:  GenericPeerIDs[1],
+				Error: "function is being executed",
+			},
+		},
+		NoAnswer:    []peer.ID{GenericPeerIDs[2]},
+		CompletedAt: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+	}
 )
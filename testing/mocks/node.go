@@ -4,15 +4,37 @@ import (
 	"context"
 	"testing"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/Maelkum/b7s/models/bls"
 	"github.com/Maelkum/b7s/models/codes"
 	"github.com/Maelkum/b7s/models/execute"
 )
 
 // APINode implements the `Node` interface expected by the API.
 type APINode struct {
-	ExecuteFunctionFunc        func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
-	ExecutionResultFunc        func(id string) (execute.ResultMap, bool)
-	PublishFunctionInstallFunc func(ctx context.Context, uri string, cid string, subgroup string) error
+	ExecuteFunctionFunc                func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
+	ExecuteFunctionWithProgressFunc    func(context.Context, execute.Request, string, func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
+	ExecuteFunctionAsyncFunc           func(context.Context, execute.Request, string) (string, error)
+	ExecuteFunctionIdempotentFunc      func(context.Context, string, execute.Request, string, func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
+	ExecuteFunctionAsyncIdempotentFunc func(context.Context, string, execute.Request, string) (string, error)
+	ExecutePipelineFunc                func(context.Context, execute.Pipeline, string) (codes.Code, []execute.PipelineStepResult, error)
+	ExecuteBatchFunc                   func(context.Context, execute.Batch, string) (codes.Code, string, []execute.BatchItemResult, execute.Cluster, error)
+	ExecuteBatchAsyncFunc              func(context.Context, execute.Batch, string) (string, error)
+	ExecutionResultFunc                func(ctx context.Context, id string) (bls.ExecutionRecord, bool)
+	ExecutionStatusFunc                func(ctx context.Context, id string) (execute.Status, bls.ExecutionRecord, bool)
+	CancelExecutionFunc                func(ctx context.Context, id string) ([]peer.ID, []peer.ID, error)
+	CreateScheduleFunc                 func(ctx context.Context, cron string, req execute.Request, subgroup string) (bls.Schedule, error)
+	SchedulesFunc                      func(ctx context.Context) []bls.Schedule
+	ReputationsFunc                    func() []bls.Reputation
+	PauseScheduleFunc                  func(ctx context.Context, id string, paused bool) (bls.Schedule, error)
+	DeleteScheduleFunc                 func(ctx context.Context, id string) error
+	SignResponseFunc                   func(res *execute.SignedResponse) error
+	InstallFunctionFunc                func(ctx context.Context, uri string, cid string, subgroup string) (bls.FunctionInstall, error)
+	InstallFunctionAsyncFunc           func(ctx context.Context, uri string, cid string, subgroup string) (string, error)
+	FunctionInstallResultFunc          func(ctx context.Context, id string) (bls.FunctionInstall, bool)
+	UninstallFunctionFunc              func(ctx context.Context, cid string, subgroup string) (bls.FunctionUninstall, error)
+	FunctionInventoryFunc              func(ctx context.Context, subgroup string) (map[peer.ID][]bls.InstalledFunction, error)
 }
 
 func BaselineNode(t *testing.T) *APINode {
@@ -24,12 +46,105 @@ func BaselineNode(t *testing.T) *APINode {
 			// TODO: Add a generic cluster info
 			return GenericExecutionResult.Code, GenericUUID.String(), GenericExecutionResultMap, execute.Cluster{}, nil
 		},
-		ExecutionResultFunc: func(id string) (execute.ResultMap, bool) {
-			return GenericExecutionResultMap, true
+		ExecuteFunctionWithProgressFunc: func(_ context.Context, _ execute.Request, _ string, progress func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+
+			progress(execute.Event{
+				Type:      execute.EventRollCall,
+				RequestID: GenericUUID.String(),
+				Peer:      GenericPeerID,
+			})
+			progress(execute.Event{
+				Type:      execute.EventResult,
+				RequestID: GenericUUID.String(),
+				Peer:      GenericPeerID,
+				Code:      GenericExecutionResult.Code,
+			})
+
+			return GenericExecutionResult.Code, GenericUUID.String(), GenericExecutionResultMap, execute.Cluster{}, nil
+		},
+		ExecuteFunctionAsyncFunc: func(context.Context, execute.Request, string) (string, error) {
+			return GenericUUID.String(), nil
+		},
+		ExecuteFunctionIdempotentFunc: func(context.Context, string, execute.Request, string, func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			return GenericExecutionResult.Code, GenericUUID.String(), GenericExecutionResultMap, execute.Cluster{}, nil
+		},
+		ExecuteFunctionAsyncIdempotentFunc: func(context.Context, string, execute.Request, string) (string, error) {
+			return GenericUUID.String(), nil
+		},
+		ExecutePipelineFunc: func(_ context.Context, pipeline execute.Pipeline, _ string) (codes.Code, []execute.PipelineStepResult, error) {
+
+			steps := make([]execute.PipelineStepResult, 0, len(pipeline))
+			for range pipeline {
+				steps = append(steps, execute.PipelineStepResult{
+					RequestID: GenericUUID.String(),
+					Code:      codes.OK,
+					Results:   GenericExecutionResultMap,
+				})
+			}
+
+			return codes.OK, steps, nil
+		},
+		ExecuteBatchFunc: func(_ context.Context, batch execute.Batch, _ string) (codes.Code, string, []execute.BatchItemResult, execute.Cluster, error) {
+
+			items := make([]execute.BatchItemResult, 0, len(batch.Items))
+			for range batch.Items {
+				items = append(items, execute.BatchItemResult{
+					RequestID: GenericUUID.String(),
+					Code:      codes.OK,
+					Peer:      GenericPeerID,
+					Result:    GenericExecutionResult.Result,
+				})
+			}
+
+			return codes.OK, GenericUUID.String(), items, execute.Cluster{}, nil
+		},
+		ExecuteBatchAsyncFunc: func(context.Context, execute.Batch, string) (string, error) {
+			return GenericUUID.String(), nil
+		},
+		ExecutionResultFunc: func(context.Context, string) (bls.ExecutionRecord, bool) {
+			return GenericExecutionRecord, true
+		},
+		ExecutionStatusFunc: func(context.Context, string) (execute.Status, bls.ExecutionRecord, bool) {
+			return GenericExecutionRecord.Status, GenericExecutionRecord, true
 		},
-		PublishFunctionInstallFunc: func(ctx context.Context, uri string, cid string, subgroup string) error {
+		CancelExecutionFunc: func(context.Context, string) ([]peer.ID, []peer.ID, error) {
+			return []peer.ID{GenericPeerID}, []peer.ID{GenericPeerID}, nil
+		},
+		CreateScheduleFunc: func(context.Context, string, execute.Request, string) (bls.Schedule, error) {
+			return GenericSchedule, nil
+		},
+		SchedulesFunc: func(context.Context) []bls.Schedule {
+			return []bls.Schedule{GenericSchedule}
+		},
+		ReputationsFunc: func() []bls.Reputation {
+			return []bls.Reputation{GenericReputation}
+		},
+		PauseScheduleFunc: func(_ context.Context, _ string, paused bool) (bls.Schedule, error) {
+			schedule := GenericSchedule
+			schedule.Paused = paused
+			return schedule, nil
+		},
+		DeleteScheduleFunc: func(context.Context, string) error {
 			return nil
 		},
+		SignResponseFunc: func(*execute.SignedResponse) error {
+			return nil
+		},
+		InstallFunctionFunc: func(context.Context, string, string, string) (bls.FunctionInstall, error) {
+			return GenericFunctionInstall, nil
+		},
+		InstallFunctionAsyncFunc: func(context.Context, string, string, string) (string, error) {
+			return GenericUUID.String(), nil
+		},
+		FunctionInstallResultFunc: func(context.Context, string) (bls.FunctionInstall, bool) {
+			return GenericFunctionInstall, true
+		},
+		UninstallFunctionFunc: func(context.Context, string, string) (bls.FunctionUninstall, error) {
+			return GenericFunctionUninstall, nil
+		},
+		FunctionInventoryFunc: func(context.Context, string) (map[peer.ID][]bls.InstalledFunction, error) {
+			return map[peer.ID][]bls.InstalledFunction{GenericPeerID: {GenericFunctionRecord.Installed()}}, nil
+		},
 	}
 
 	return &node
@@ -39,10 +154,86 @@ func (n *APINode) ExecuteFunction(ctx context.Context, req execute.Request, subg
 	return n.ExecuteFunctionFunc(ctx, req, subgroup)
 }
 
-func (n *APINode) ExecutionResult(id string) (execute.ResultMap, bool) {
-	return n.ExecutionResultFunc(id)
+func (n *APINode) ExecuteFunctionWithProgress(ctx context.Context, req execute.Request, subgroup string, progress func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+	return n.ExecuteFunctionWithProgressFunc(ctx, req, subgroup, progress)
+}
+
+func (n *APINode) ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string) (string, error) {
+	return n.ExecuteFunctionAsyncFunc(ctx, req, subgroup)
+}
+
+func (n *APINode) ExecuteFunctionIdempotent(ctx context.Context, key string, req execute.Request, subgroup string, progress func(execute.Event)) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+	return n.ExecuteFunctionIdempotentFunc(ctx, key, req, subgroup, progress)
+}
+
+func (n *APINode) ExecuteFunctionAsyncIdempotent(ctx context.Context, key string, req execute.Request, subgroup string) (string, error) {
+	return n.ExecuteFunctionAsyncIdempotentFunc(ctx, key, req, subgroup)
+}
+
+func (n *APINode) ExecutePipeline(ctx context.Context, pipeline execute.Pipeline, subgroup string) (codes.Code, []execute.PipelineStepResult, error) {
+	return n.ExecutePipelineFunc(ctx, pipeline, subgroup)
+}
+
+func (n *APINode) ExecuteBatch(ctx context.Context, batch execute.Batch, subgroup string) (codes.Code, string, []execute.BatchItemResult, execute.Cluster, error) {
+	return n.ExecuteBatchFunc(ctx, batch, subgroup)
+}
+
+func (n *APINode) ExecuteBatchAsync(ctx context.Context, batch execute.Batch, subgroup string) (string, error) {
+	return n.ExecuteBatchAsyncFunc(ctx, batch, subgroup)
+}
+
+func (n *APINode) ExecutionStatus(ctx context.Context, id string) (execute.Status, bls.ExecutionRecord, bool) {
+	return n.ExecutionStatusFunc(ctx, id)
+}
+
+func (n *APINode) CancelExecution(ctx context.Context, id string) ([]peer.ID, []peer.ID, error) {
+	return n.CancelExecutionFunc(ctx, id)
+}
+
+func (n *APINode) ExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, bool) {
+	return n.ExecutionResultFunc(ctx, id)
+}
+
+func (n *APINode) CreateSchedule(ctx context.Context, cron string, req execute.Request, subgroup string) (bls.Schedule, error) {
+	return n.CreateScheduleFunc(ctx, cron, req, subgroup)
+}
+
+func (n *APINode) Schedules(ctx context.Context) []bls.Schedule {
+	return n.SchedulesFunc(ctx)
+}
+
+func (n *APINode) Reputations() []bls.Reputation {
+	return n.ReputationsFunc()
+}
+
+func (n *APINode) PauseSchedule(ctx context.Context, id string, paused bool) (bls.Schedule, error) {
+	return n.PauseScheduleFunc(ctx, id, paused)
+}
+
+func (n *APINode) DeleteSchedule(ctx context.Context, id string) error {
+	return n.DeleteScheduleFunc(ctx, id)
+}
+
+func (n *APINode) SignResponse(res *execute.SignedResponse) error {
+	return n.SignResponseFunc(res)
+}
+
+func (n *APINode) InstallFunction(ctx context.Context, uri string, cid string, subgroup string) (bls.FunctionInstall, error) {
+	return n.InstallFunctionFunc(ctx, uri, cid, subgroup)
+}
+
+func (n *APINode) InstallFunctionAsync(ctx context.Context, uri string, cid string, subgroup string) (string, error) {
+	return n.InstallFunctionAsyncFunc(ctx, uri, cid, subgroup)
+}
+
+func (n *APINode) FunctionInstallResult(ctx context.Context, id string) (bls.FunctionInstall, bool) {
+	return n.FunctionInstallResultFunc(ctx, id)
+}
+
+func (n *APINode) UninstallFunction(ctx context.Context, cid string, subgroup string) (bls.FunctionUninstall, error) {
+	return n.UninstallFunctionFunc(ctx, cid, subgroup)
 }
 
-func (n *APINode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error {
-	return n.PublishFunctionInstallFunc(ctx, uri, cid, subgroup)
+func (n *APINode) FunctionInventory(ctx context.Context, subgroup string) (map[peer.ID][]bls.InstalledFunction, error) {
+	return n.FunctionInventoryFunc(ctx, subgroup)
 }
@@ -19,6 +19,21 @@ type Store struct {
 	RetrieveFunctionFunc  func(context.Context, string) (bls.FunctionRecord, error)
 	RetrieveFunctionsFunc func(context.Context) ([]bls.FunctionRecord, error)
 	RemoveFunctionFunc    func(context.Context, string) error
+
+	SaveExecutionFunc      func(context.Context, bls.ExecutionRecord) error
+	RetrieveExecutionFunc  func(context.Context, string) (bls.ExecutionRecord, error)
+	RetrieveExecutionsFunc func(context.Context) ([]bls.ExecutionRecord, error)
+	RemoveExecutionFunc    func(context.Context, string) error
+
+	SaveScheduleFunc      func(context.Context, bls.Schedule) error
+	RetrieveScheduleFunc  func(context.Context, string) (bls.Schedule, error)
+	RetrieveSchedulesFunc func(context.Context) ([]bls.Schedule, error)
+	RemoveScheduleFunc    func(context.Context, string) error
+
+	SaveReputationFunc      func(context.Context, bls.Reputation) error
+	RetrieveReputationFunc  func(context.Context, peer.ID) (bls.Reputation, error)
+	RetrieveReputationsFunc func(context.Context) ([]bls.Reputation, error)
+	RemoveReputationFunc    func(context.Context, peer.ID) error
 }
 
 func BaselineStore(t *testing.T) *Store {
@@ -50,6 +65,45 @@ func BaselineStore(t *testing.T) *Store {
 		RemoveFunctionFunc: func(context.Context, string) error {
 			return nil
 		},
+
+		SaveExecutionFunc: func(context.Context, bls.ExecutionRecord) error {
+			return nil
+		},
+		RetrieveExecutionFunc: func(context.Context, string) (bls.ExecutionRecord, error) {
+			return GenericExecutionRecord, nil
+		},
+		RetrieveExecutionsFunc: func(context.Context) ([]bls.ExecutionRecord, error) {
+			return []bls.ExecutionRecord{GenericExecutionRecord}, nil
+		},
+		RemoveExecutionFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveScheduleFunc: func(context.Context, bls.Schedule) error {
+			return nil
+		},
+		RetrieveScheduleFunc: func(context.Context, string) (bls.Schedule, error) {
+			return GenericSchedule, nil
+		},
+		RetrieveSchedulesFunc: func(context.Context) ([]bls.Schedule, error) {
+			return []bls.Schedule{GenericSchedule}, nil
+		},
+		RemoveScheduleFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveReputationFunc: func(context.Context, bls.Reputation) error {
+			return nil
+		},
+		RetrieveReputationFunc: func(context.Context, peer.ID) (bls.Reputation, error) {
+			return GenericReputation, nil
+		},
+		RetrieveReputationsFunc: func(context.Context) ([]bls.Reputation, error) {
+			return []bls.Reputation{GenericReputation}, nil
+		},
+		RemoveReputationFunc: func(context.Context, peer.ID) error {
+			return nil
+		},
 	}
 
 	return &store
@@ -79,3 +133,40 @@ func (s *Store) RemovePeer(ctx context.Context, id peer.ID) error {
 func (s *Store) RemoveFunction(ctx context.Context, id string) error {
 	return s.RemoveFunctionFunc(ctx, id)
 }
+func (s *Store) SaveExecution(ctx context.Context, record bls.ExecutionRecord) error {
+	return s.SaveExecutionFunc(ctx, record)
+}
+func (s *Store) RetrieveExecution(ctx context.Context, requestID string) (bls.ExecutionRecord, error) {
+	return s.RetrieveExecutionFunc(ctx, requestID)
+}
+func (s *Store) RetrieveExecutions(ctx context.Context) ([]bls.ExecutionRecord, error) {
+	return s.RetrieveExecutionsFunc(ctx)
+}
+func (s *Store) RemoveExecution(ctx context.Context, requestID string) error {
+	return s.RemoveExecutionFunc(ctx, requestID)
+}
+func (s *Store) SaveSchedule(ctx context.Context, schedule bls.Schedule) error {
+	return s.SaveScheduleFunc(ctx, schedule)
+}
+func (s *Store) RetrieveSchedule(ctx context.Context, id string) (bls.Schedule, error) {
+	return s.RetrieveScheduleFunc(ctx, id)
+}
+func (s *Store) RetrieveSchedules(ctx context.Context) ([]bls.Schedule, error) {
+	return s.RetrieveSchedulesFunc(ctx)
+}
+func (s *Store) RemoveSchedule(ctx context.Context, id string) error {
+	return s.RemoveScheduleFunc(ctx, id)
+}
+
+func (s *Store) SaveReputation(ctx context.Context, reputation bls.Reputation) error {
+	return s.SaveReputationFunc(ctx, reputation)
+}
+func (s *Store) RetrieveReputation(ctx context.Context, id peer.ID) (bls.Reputation, error) {
+	return s.RetrieveReputationFunc(ctx, id)
+}
+func (s *Store) RetrieveReputations(ctx context.Context) ([]bls.Reputation, error) {
+	return s.RetrieveReputationsFunc(ctx)
+}
+func (s *Store) RemoveReputation(ctx context.Context, id peer.ID) error {
+	return s.RemoveReputationFunc(ctx, id)
+}